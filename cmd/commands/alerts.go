@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/miguelmota/cointop/cointop"
+	"github.com/spf13/cobra"
+)
+
+// AlertsCmd ...
+func AlertsCmd() *cobra.Command {
+	var config string
+	var exportFile string
+	var importFile string
+
+	alertsCmd := &cobra.Command{
+		Use:   "alerts",
+		Short: "Exports or imports price alerts",
+		Long:  `The alerts command exports the price alerts to a JSON file or imports and merges price alerts from one`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if exportFile == "" && importFile == "" {
+				return cmd.Help()
+			}
+			if exportFile != "" && importFile != "" {
+				return errors.New("flags --export and --import cannot be used at the same time")
+			}
+
+			ct, err := cointop.NewCointop(&cointop.Config{
+				ConfigFilepath: config,
+			})
+			if err != nil {
+				return err
+			}
+
+			if exportFile != "" {
+				var w io.Writer = os.Stdout
+				if exportFile != "-" {
+					f, err := os.Create(exportFile)
+					if err != nil {
+						return err
+					}
+					defer f.Close()
+					w = f
+				}
+				return ct.ExportAlerts(w)
+			}
+
+			var r io.Reader = os.Stdin
+			if importFile != "-" {
+				f, err := os.Open(importFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+			added, err := ct.ImportAlerts(r)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("imported %d price alerts\n", added)
+			return nil
+		},
+	}
+
+	alertsCmd.Flags().StringVarP(&config, "config", "c", "", fmt.Sprintf("Config filepath. (default %s)", cointop.DefaultConfigFilepath))
+	alertsCmd.Flags().StringVarP(&exportFile, "export", "e", "", `Export the price alerts to a JSON file. Use "-" for stdout`)
+	alertsCmd.Flags().StringVarP(&importFile, "import", "i", "", `Import price alerts from a JSON file, skipping alerts that already exist. Use "-" for stdin`)
+
+	return alertsCmd
+}
@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/miguelmota/cointop/cointop"
-	"github.com/miguelmota/cointop/pkg/filecache"
 	"github.com/spf13/cobra"
 )
 
 // CleanCmd ...
 func CleanCmd() *cobra.Command {
-	cacheDir := filecache.DefaultCacheDir
+	var cacheDir string
 
 	cleanCmd := &cobra.Command{
 		Use:   "clean",
@@ -23,7 +24,7 @@ func CleanCmd() *cobra.Command {
 		},
 	}
 
-	cleanCmd.Flags().StringVarP(&cacheDir, "cache-dir", "", cacheDir, "Cache directory")
+	cleanCmd.Flags().StringVarP(&cacheDir, "cache-dir", "", cacheDir, fmt.Sprintf("Cache directory (default %s)", cointop.DefaultCacheDir))
 
 	return cleanCmd
 }
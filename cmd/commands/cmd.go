@@ -13,7 +13,9 @@ func Execute() {
 		CleanCmd(),
 		ResetCmd(),
 		HoldingsCmd(),
+		AlertsCmd(),
 		PriceCmd(),
+		WatchCmd(),
 		DominanceCmd(),
 		ServerCmd(),
 		TestCmd(),
@@ -19,6 +19,7 @@ func HoldingsCmd() *cobra.Command {
 	var humanReadable bool
 	var filter []string
 	var convert string
+	var importCSV string
 
 	holdingsCmd := &cobra.Command{
 		Use:   "holdings",
@@ -31,12 +32,15 @@ func HoldingsCmd() *cobra.Command {
 
 			ct, err := cointop.NewCointop(&cointop.Config{
 				ConfigFilepath: config,
-				CacheDir:       cointop.DefaultCacheDir,
 			})
 			if err != nil {
 				return err
 			}
 
+			if importCSV != "" {
+				return ct.ImportPortfolioCSV(importCSV)
+			}
+
 			if total {
 				return ct.PrintTotalHoldings(&cointop.TablePrintOptions{
 					HumanReadable: humanReadable,
@@ -67,6 +71,7 @@ func HoldingsCmd() *cobra.Command {
 	holdingsCmd.Flags().StringVarP(&format, "format", "", format, `Ouput format. Options are "table", "csv", "json"`)
 	holdingsCmd.Flags().StringSliceVarP(&filter, "filter", "", filter, `Filter portfolio entries by coin name or symbol, comma separated. Example: "btc,eth,doge"`)
 	holdingsCmd.Flags().StringVarP(&convert, "convert", "f", convert, "The currency to convert to")
+	holdingsCmd.Flags().StringVarP(&importCSV, "import-csv", "", importCSV, "Import holdings from a CSV file with rows of coin,holdings[,cost_basis]")
 
 	return holdingsCmd
 }
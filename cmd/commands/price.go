@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/miguelmota/cointop/cointop"
 	"github.com/spf13/cobra"
@@ -13,11 +14,12 @@ func PriceCmd() *cobra.Command {
 	var coin string
 	var coins []string
 	var currency string
+	var config string
 
 	priceCmd := &cobra.Command{
-		Use:   "price",
+		Use:   "price [coin]...",
 		Short: "Displays the current price of coin(s)",
-		Long:  `The price command display the current price of a coin`,
+		Long:  `The price command display the current price of a coin. It uses the API choice and currency of the config file unless the --api or --currency flags are set`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if coin != "" {
 				if len(coins) > 0 {
@@ -25,18 +27,51 @@ func PriceCmd() *cobra.Command {
 				}
 				coins = append(coins, coin)
 			}
-			return cointop.PrintPrices(&cointop.PricesConfig{
-				Coins:     coins,
-				Currency:  currency,
-				APIChoice: apiChoice,
+			coins = append(coins, args...)
+
+			// NOTE: an explicit API choice is used as is so it isn't saved to the config file
+			if apiChoice != "" {
+				if currency == "" {
+					var err error
+					currency, err = cointop.ConfigCurrency(config)
+					if err != nil {
+						return err
+					}
+				}
+				return cointop.PrintPrices(&cointop.PricesConfig{
+					Coins:     coins,
+					Currency:  currency,
+					APIChoice: apiChoice,
+				})
+			}
+
+			if len(coins) == 0 {
+				return cointop.ErrCoinNameOrSymbolRequired
+			}
+
+			ct, err := cointop.NewCointop(&cointop.Config{
+				ConfigFilepath: config,
+				NoPrompts:      true,
 			})
+			if err != nil {
+				return err
+			}
+
+			for _, name := range coins {
+				if err := ct.PrintPrice(name, currency); err != nil {
+					return err
+				}
+			}
+
+			return nil
 		},
 	}
 
 	priceCmd.Flags().StringSliceVarP(&coins, "coins", "c", nil, "Name or symbol of coin(s), comma separated. E.g. \"Bitcoin\" Eg. \"btc,eth,doge\"")
 	priceCmd.Flags().StringVarP(&coin, "coin", "", "", "Name or symbol of coin. Alias for --coins")
-	priceCmd.Flags().StringVarP(&currency, "currency", "f", "USD", "The currency to convert to")
-	priceCmd.Flags().StringVarP(&apiChoice, "api", "a", cointop.CoinGecko, "API choice. Available choices are \"coinmarketcap\" and \"coingecko\"")
+	priceCmd.Flags().StringVarP(&currency, "currency", "f", "", "The currency to convert to. Defaults to the configured currency")
+	priceCmd.Flags().StringVarP(&apiChoice, "api", "a", "", "API choice. Available choices are \"coinmarketcap\", \"coingecko\" and \"coinpaprika\". Defaults to the configured API")
+	priceCmd.Flags().StringVarP(&config, "config", "", "", fmt.Sprintf("Config filepath. (default %s)", cointop.DefaultConfigFilepath))
 
 	return priceCmd
 }
@@ -1,14 +1,15 @@
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/miguelmota/cointop/cointop"
-	"github.com/miguelmota/cointop/pkg/filecache"
 	"github.com/spf13/cobra"
 )
 
 // ResetCmd ...
 func ResetCmd() *cobra.Command {
-	cacheDir := filecache.DefaultCacheDir
+	var cacheDir string
 
 	resetCmd := &cobra.Command{
 		Use:   "reset",
@@ -23,7 +24,7 @@ func ResetCmd() *cobra.Command {
 		},
 	}
 
-	resetCmd.Flags().StringVarP(&cacheDir, "cache-dir", "", cacheDir, "Cache directory")
+	resetCmd.Flags().StringVarP(&cacheDir, "cache-dir", "", cacheDir, fmt.Sprintf("Cache directory (default %s)", cointop.DefaultCacheDir))
 
 	return resetCmd
 }
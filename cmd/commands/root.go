@@ -19,6 +19,9 @@ func RootCmd() *cobra.Command {
 	var onlyTable bool
 	var silent bool
 	var noCache bool
+	var noColor bool
+	var offline bool
+	var demo bool
 	var refreshRate uint
 	var config string
 	var cmcAPIKey string
@@ -26,7 +29,11 @@ func RootCmd() *cobra.Command {
 	var colorscheme string
 	var perPage = cointop.DefaultPerPage
 	var cacheDir string
+	var dataDir string
 	var colorsDir string
+	var output string
+	var currency string
+	var limit int
 
 	rootCmd := &cobra.Command{
 		Use:   "cointop",
@@ -72,6 +79,10 @@ See git.io/cointop for more info.`,
 				}
 			}
 
+			if output != "" && output != "json" {
+				return fmt.Errorf("the option %q is not a valid output format", output)
+			}
+
 			var refreshRateP *uint
 			if cmd.Flags().Changed("refresh-rate") {
 				refreshRateP = &refreshRate
@@ -79,8 +90,10 @@ See git.io/cointop for more info.`,
 
 			ct, err := cointop.NewCointop(&cointop.Config{
 				CacheDir:            cacheDir,
+				DataDir:             dataDir,
 				ColorsDir:           colorsDir,
 				NoCache:             noCache,
+				NoColor:             noColor,
 				ConfigFilepath:      config,
 				CoinMarketCapAPIKey: cmcAPIKey,
 				APIChoice:           apiChoice,
@@ -89,6 +102,8 @@ See git.io/cointop for more info.`,
 				HideChart:           hideChart,
 				HideStatusbar:       hideStatusbar,
 				OnlyTable:           onlyTable,
+				Offline:             offline,
+				DemoMode:            demo,
 				RefreshRate:         refreshRateP,
 				PerPage:             perPage,
 			})
@@ -96,6 +111,10 @@ See git.io/cointop for more info.`,
 				return err
 			}
 
+			if output == "json" {
+				return ct.PrintCoinsJSON(limit, currency)
+			}
+
 			return ct.Run()
 		},
 	}
@@ -110,14 +129,21 @@ See git.io/cointop for more info.`,
 	rootCmd.Flags().BoolVarP(&onlyTable, "only-table", "", false, "Show only the table. Hides the chart and top and bottom bars")
 	rootCmd.Flags().BoolVarP(&silent, "silent", "s", false, "Silence log ouput")
 	rootCmd.Flags().BoolVarP(&noCache, "no-cache", "", false, "No cache")
+	rootCmd.Flags().BoolVarP(&noColor, "no-color", "", false, "Disable colors. Also enabled by the NO_COLOR environment variable")
+	rootCmd.Flags().BoolVarP(&offline, "offline", "", false, "Start in offline mode showing cached data. Press ctrl+r to go online")
+	rootCmd.Flags().BoolVarP(&demo, "demo", "", false, "Start in read-only demo mode showing sample data without making any requests")
 	rootCmd.Flags().UintVarP(&refreshRate, "refresh-rate", "r", 60, "Refresh rate in seconds. Set to 0 to not auto-refresh")
 	rootCmd.Flags().UintVarP(&perPage, "per-page", "", perPage, "Per page")
 	rootCmd.Flags().StringVarP(&config, "config", "c", "", fmt.Sprintf("Config filepath. (default %s)", cointop.DefaultConfigFilepath))
 	rootCmd.Flags().StringVarP(&cmcAPIKey, "coinmarketcap-api-key", "", "", "Set the CoinMarketCap API key")
-	rootCmd.Flags().StringVarP(&apiChoice, "api", "", "", "API choice. Available choices are \"coinmarketcap\" and \"coingecko\"")
+	rootCmd.Flags().StringVarP(&apiChoice, "api", "", "", "API choice. Available choices are \"coinmarketcap\", \"coingecko\" and \"coinpaprika\"")
 	rootCmd.Flags().StringVarP(&colorscheme, "colorscheme", "", "", fmt.Sprintf("Colorscheme to use (default \"cointop\").\n%s", cointop.ColorschemeHelpString()))
 	rootCmd.Flags().StringVarP(&cacheDir, "cache-dir", "", cacheDir, fmt.Sprintf("Cache directory (default %s)", cointop.DefaultCacheDir))
+	rootCmd.Flags().StringVarP(&dataDir, "data-dir", "", dataDir, fmt.Sprintf("Data directory of the portfolio and price alerts files and the rank history (default %s)", cointop.DefaultDataDir))
 	rootCmd.Flags().StringVarP(&colorsDir, "colors-dir", "", colorsDir, "Colorschemes directory")
+	rootCmd.Flags().StringVarP(&output, "output", "o", "", "Output the coins in the format instead of starting the UI. Available format is \"json\"")
+	rootCmd.Flags().StringVarP(&currency, "currency", "f", "", "The currency to convert to with --output. Defaults to the currency of the config file")
+	rootCmd.Flags().IntVarP(&limit, "limit", "", 0, "Max number of coins to output with --output. Set to 0 to output all the fetched coins")
 
 	return rootCmd
 }
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/miguelmota/cointop/cointop"
+	"github.com/spf13/cobra"
+)
+
+// WatchCmd ...
+func WatchCmd() *cobra.Command {
+	var apiChoice string
+	var coins []string
+	var currency string
+	var interval uint
+	var count int
+
+	watchCmd := &cobra.Command{
+		Use:   "watch [coins]",
+		Short: "Prints the current price of coin(s) at an interval",
+		Long:  `The watch command prints a line with the current price of each coin, tab separated and in the order given, at every interval. Coins that aren't found are printed as NA`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for _, arg := range args {
+				for _, coin := range strings.Split(arg, ",") {
+					if coin = strings.TrimSpace(coin); coin != "" {
+						coins = append(coins, coin)
+					}
+				}
+			}
+			return cointop.WatchPrices(&cointop.WatchConfig{
+				Coins:     coins,
+				Currency:  currency,
+				APIChoice: apiChoice,
+				Interval:  time.Duration(interval) * time.Second,
+				Count:     count,
+			})
+		},
+	}
+
+	watchCmd.Flags().StringSliceVarP(&coins, "coins", "c", nil, "Name or symbol of coin(s), comma separated. E.g. \"btc,eth,sol\"")
+	watchCmd.Flags().StringVarP(&currency, "currency", "f", "USD", "The currency to convert to")
+	watchCmd.Flags().StringVarP(&apiChoice, "api", "a", cointop.CoinGecko, "API choice. Available choices are \"coinmarketcap\", \"coingecko\" and \"coinpaprika\"")
+	watchCmd.Flags().UintVarP(&interval, "interval", "i", 60, "Interval in seconds between lines")
+	watchCmd.Flags().IntVarP(&count, "count", "n", 0, "Number of lines to print. Set to 0 to watch until stopped")
+
+	return watchCmd
+}
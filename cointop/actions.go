@@ -7,6 +7,7 @@ func ActionsMap() map[string]bool {
 		"help":                              true,
 		"toggle_show_help":                  true,
 		"close_help":                        true,
+		"context_help":                      true,
 		"last_page":                         true,
 		"move_to_page_first_row":            true,
 		"move_to_page_last_row":             true,
@@ -17,12 +18,16 @@ func ActionsMap() map[string]bool {
 		"move_down":                         true,
 		"next_page":                         true,
 		"open_link":                         true,
+		"toggle_mark":                       true,
+		"open_marked_links":                 true,
 		"page_down":                         true,
 		"page_up":                           true,
 		"previous_page":                     true,
 		"quit":                              true,
 		"quit_view":                         true,
 		"refresh":                           true,
+		"full_refresh":                      true,
+		"toggle_pause_refresh":              true,
 		"sort_column_1h_change":             true,
 		"sort_column_24h_change":            true,
 		"sort_column_24h_volume":            true,
@@ -53,11 +58,148 @@ func ActionsMap() map[string]bool {
 		"hide_currency_convert_menu":        true,
 		"toggle_portfolio":                  true,
 		"toggle_show_portfolio":             true,
+		"toggle_recently_added":             true,
+		"toggle_gainers":                    true,
+		"toggle_losers":                     true,
+		"export_table_to_markdown":          true,
+		"copy_link":                         true,
+		"show_per_page_menu":                true,
+		"show_go_to_rank_menu":              true,
+		"show_favorite_group_menu":          true,
+		"show_filter_menu":                  true,
+		"show_market_cap_menu":              true,
+		"show_column_menu":                  true,
+		"toggle_chart_pin":                  true,
+		"toggle_chart_volume":               true,
+		"export_chart_csv":                  true,
+		"toggle_rank_column":                true,
+		"cycle_change_window":               true,
+		"show_coin_debug":                   true,
+		"show_coin_value":                   true,
+		"blacklist_coin":                    true,
+		"toggle_base_currency":              true,
+		"toggle_infobar":                    true,
+		"toggle_ticker":                     true,
+		"toggle_favorites_summary":          true,
+		"go_home":                           true,
+		"add_to_portfolio":                  true,
+		"undo_removal":                      true,
+		"toggle_volume_units":               true,
+		"toggle_privacy_mode":               true,
+		"toggle_chart_sma":                  true,
+		"toggle_chart_candles":              true,
+		"toggle_chart_log_scale":            true,
+		"toggle_chart_compare":              true,
+		"move_to_top_gainer":                true,
+		"move_to_top_loser":                 true,
 		"enlarge_chart":                     true,
 		"shorten_chart":                     true,
 	}
 }
 
+// tableActions are the actions available in every table view
+var tableActions = []string{
+	"move_up",
+	"move_down",
+	"page_up",
+	"page_down",
+	"previous_page",
+	"next_page",
+	"first_page",
+	"last_page",
+	"move_to_page_first_row",
+	"move_to_page_last_row",
+	"move_down_or_next_page",
+	"sort_column_asc",
+	"sort_column_desc",
+	"sort_left_column",
+	"sort_right_column",
+	"scroll_left",
+	"scroll_right",
+	"refresh",
+	"toggle_pause_refresh",
+	"open_search",
+	"help",
+	"context_help",
+	"quit_view",
+}
+
+// coinActions are the actions available in the views that list coins
+var coinActions = []string{
+	"toggle_row_chart",
+	"toggle_favorite",
+	"open_link",
+	"copy_link",
+	"toggle_mark",
+	"open_marked_links",
+	"add_to_portfolio",
+	"show_portfolio_edit_menu",
+	"show_price_alert_add_menu",
+	"show_coin_value",
+	"blacklist_coin",
+	"sort_column_1h_change",
+	"sort_column_24h_change",
+	"sort_column_7d_change",
+	"sort_column_30d_change",
+	"sort_column_24h_volume",
+	"sort_column_market_cap",
+	"sort_column_name",
+	"sort_column_price",
+	"sort_column_rank",
+	"sort_column_symbol",
+}
+
+// ViewActionsMap returns a map of the actions relevant to each table view
+func ViewActionsMap() map[string][]string {
+	coins := append(append([]string{}, tableActions...), coinActions...)
+	return map[string][]string{
+		CoinsView: append(append([]string{}, coins...),
+			"toggle_show_favorites",
+			"toggle_portfolio",
+			"toggle_price_alerts",
+			"toggle_recently_added",
+			"toggle_gainers",
+			"toggle_losers",
+			"show_go_to_rank_menu",
+			"sort_column_available_supply",
+			"sort_column_total_supply",
+			"sort_column_last_updated",
+		),
+		FavoritesView: append(append([]string{}, coins...),
+			"toggle_show_favorites",
+			"toggle_favorites_summary",
+			"show_favorite_group_menu",
+		),
+		PortfolioView: append(append([]string{}, coins...),
+			"toggle_portfolio",
+			"toggle_privacy_mode",
+			"undo_removal",
+			"sort_column_balance",
+			"sort_column_holdings",
+			"sort_column_percent_holdings",
+		),
+		PriceAlertsView: append(append([]string{}, tableActions...),
+			"toggle_price_alerts",
+			"show_price_alert_add_menu",
+			"show_price_alert_edit_menu",
+			"sort_column_name",
+			"sort_column_symbol",
+			"sort_column_price",
+		),
+		RecentlyAddedView: append(append([]string{}, coins...),
+			"toggle_recently_added",
+		),
+		GainersView: append(append([]string{}, coins...),
+			"toggle_gainers",
+			"toggle_losers",
+		),
+		LosersView: append(append([]string{}, coins...),
+			"toggle_gainers",
+			"toggle_losers",
+		),
+	}
+}
+
 // ActionExists returns true if action exists
 func (ct *Cointop) ActionExists(action string) bool {
 	return ct.ActionsMap[action]
@@ -0,0 +1,125 @@
+package cointop
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// PriceAlertsExport is the JSON format price alerts are exported to and imported from
+type PriceAlertsExport struct {
+	Alerts []*PriceAlert `json:"alerts"`
+}
+
+// ExportAlerts writes the price alerts as JSON
+func (ct *Cointop) ExportAlerts(w io.Writer) error {
+	ct.debuglog("exportAlerts()")
+	export := &PriceAlertsExport{
+		Alerts: ct.State.priceAlerts.Entries,
+	}
+	if export.Alerts == nil {
+		export.Alerts = []*PriceAlert{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(export)
+}
+
+// ImportAlerts reads price alerts exported as JSON and merges them into the price alerts. Expired alerts and alerts
+// for the same coin, operator and target price as an existing alert are skipped. Every coin must be found by the API
+// or nothing is imported. It returns the number of alerts added
+func (ct *Cointop) ImportAlerts(r io.Reader) (int, error) {
+	ct.debuglog("importAlerts()")
+	var export PriceAlertsExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return 0, fmt.Errorf("invalid alerts file: %w", err)
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	var alerts []*PriceAlert
+	for _, alert := range export.Alerts {
+		if alert == nil || strings.TrimSpace(alert.CoinName) == "" {
+			return 0, ErrInvalidPriceAlert
+		}
+		if alert.Expired {
+			continue
+		}
+		if _, ok := PriceAlertOperatorMap[alert.Operator]; !ok {
+			return 0, fmt.Errorf("invalid price alert operator %q for %s", alert.Operator, alert.CoinName)
+		}
+		if alert.Frequency == "" {
+			alert.Frequency = "once"
+		}
+		if _, ok := PriceAlertFrequencyMap[alert.Frequency]; !ok {
+			return 0, fmt.Errorf("invalid price alert frequency %q for %s", alert.Frequency, alert.CoinName)
+		}
+		alerts = append(alerts, alert)
+		key := strings.ToLower(alert.CoinName)
+		if !seen[key] {
+			seen[key] = true
+			names = append(names, alert.CoinName)
+		}
+	}
+
+	if err := ct.validateAlertCoins(names); err != nil {
+		return 0, err
+	}
+
+	added := 0
+	for _, alert := range alerts {
+		if ct.hasPriceAlert(alert) {
+			continue
+		}
+		if alert.ID == "" {
+			alert.ID = strings.ToLower(fmt.Sprintf("%s_%s_%v_%s", alert.CoinName, alert.Operator, alert.TargetPrice, alert.Frequency))
+		}
+		ct.State.priceAlerts.Entries = append(ct.State.priceAlerts.Entries, alert)
+		added++
+	}
+
+	if err := ct.Save(); err != nil {
+		return 0, err
+	}
+
+	return added, nil
+}
+
+// hasPriceAlert returns true if there's a price alert for the same coin, operator and target price
+func (ct *Cointop) hasPriceAlert(alert *PriceAlert) bool {
+	for _, entry := range ct.State.priceAlerts.Entries {
+		if strings.EqualFold(entry.CoinName, alert.CoinName) && entry.Operator == alert.Operator && entry.TargetPrice == alert.TargetPrice {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAlertCoins returns an error naming the coins that aren't found by the API
+func (ct *Cointop) validateAlertCoins(names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+
+	coins, err := ct.API().GetCoinDataBatch(names, ct.State.currencyConversion)
+	if err != nil {
+		return err
+	}
+	found := make(map[string]bool)
+	for _, coin := range coins {
+		found[strings.ToLower(coin.Name)] = true
+	}
+
+	var missing []string
+	for _, name := range names {
+		if !found[strings.ToLower(name)] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("coins not found: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package cointop
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/miguelmota/cointop/pkg/api"
+)
+
+// TestImportAlerts checks that only the alerts that aren't expired are merged and the alert settings are kept
+func TestImportAlerts(t *testing.T) {
+	ct := &Cointop{
+		State: &State{
+			currencyConversion: "USD",
+			priceAlerts: &PriceAlerts{
+				Entries: []*PriceAlert{
+					{ID: "existing", CoinName: "Bitcoin", Operator: ">", TargetPrice: 50000, Frequency: "once"},
+				},
+				SoundEnabled: true,
+			},
+		},
+		api:      api.NewDemo(),
+		demoMode: true,
+	}
+
+	r := strings.NewReader(`{
+		"alerts": [
+			{"coin_name": "Bitcoin", "operator": ">", "target_price": 50000, "frequency": "once"},
+			{"coin_name": "Ethereum", "operator": "<", "target_price": 1000, "frequency": "once", "expired": true},
+			{"coin_name": "Ethereum", "operator": ">", "target_price": 5000}
+		],
+		"sound_enabled": false
+	}`)
+	added, err := ct.ImportAlerts(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 1 {
+		t.Errorf("added == %d, want 1", added)
+	}
+	entries := ct.State.priceAlerts.Entries
+	if len(entries) != 2 || entries[1].CoinName != "Ethereum" || entries[1].TargetPrice != 5000 {
+		t.Errorf("entries == %v, want the existing alert and the Ethereum alert above 5000", entries)
+	}
+	if !ct.State.priceAlerts.SoundEnabled {
+		t.Error("expected the alert sound to stay enabled")
+	}
+}
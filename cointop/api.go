@@ -0,0 +1,163 @@
+package cointop
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+
+	"github.com/miguelmota/cointop/pkg/api"
+)
+
+// maxAPIFailures is the number of consecutive failed refreshes before trying a fallback API
+const maxAPIFailures = 3
+
+// newAPI returns the API client for the API choice
+func (ct *Cointop) newAPI(apiChoice string) (api.Interface, error) {
+	var client api.Interface
+	switch apiChoice {
+	case CoinMarketCap:
+		client = api.NewCMC(ct.apiKeys.cmc, ct.cmcBaseURL)
+	case CoinGecko:
+		client = api.NewCG(ct.State.chartAutoInterval, ct.cgMaxPages, ct.cgIDOverrides, ct.cgConcurrency)
+	case CoinPaprika:
+		client = api.NewCP()
+	case Demo:
+		client = api.NewDemo()
+	default:
+		return nil, ErrInvalidAPIChoice
+	}
+
+	ct.syncAPISparkline(client)
+	return client, nil
+}
+
+// APIPreference returns the API choices in preference order
+func (ct *Cointop) APIPreference() []string {
+	list := []string{ct.apiChoice}
+	for _, apiChoice := range ct.apiFallbacks {
+		if apiChoice != ct.apiChoice {
+			list = append(list, apiChoice)
+		}
+	}
+
+	return list
+}
+
+// API returns the API client currently in use
+func (ct *Cointop) API() api.Interface {
+	ct.apiMux.RLock()
+	defer ct.apiMux.RUnlock()
+	return ct.api
+}
+
+// setAPI replaces the API client and the API choice it was created for together, so a reader never sees the client
+// of one API with the choice of another. An empty choice is the configured API choice
+func (ct *Cointop) setAPI(client api.Interface, apiChoice string) {
+	ct.apiMux.Lock()
+	defer ct.apiMux.Unlock()
+	ct.api = client
+	ct.activeAPIChoice = apiChoice
+}
+
+// ActiveAPIChoice returns the API choice currently in use
+func (ct *Cointop) ActiveAPIChoice() string {
+	ct.apiMux.RLock()
+	defer ct.apiMux.RUnlock()
+	if ct.activeAPIChoice != "" {
+		return ct.activeAPIChoice
+	}
+
+	return ct.apiChoice
+}
+
+// IsAPIFallbackActive returns true if a fallback API is in use instead of the configured one
+func (ct *Cointop) IsAPIFallbackActive() bool {
+	return ct.ActiveAPIChoice() != ct.apiChoice
+}
+
+// SelectAvailableAPI switches to the first API in preference order that responds to a ping
+func (ct *Cointop) SelectAvailableAPI() error {
+	ct.debuglog("selectAvailableAPI()")
+	active := ct.ActiveAPIChoice()
+	for _, apiChoice := range ct.APIPreference() {
+		if apiChoice == CoinMarketCap && ct.apiKeys.cmc == "" && os.Getenv("CMC_PRO_API_KEY") == "" {
+			continue
+		}
+
+		client := ct.API()
+		if client == nil || apiChoice != active {
+			var err error
+			client, err = ct.newAPI(apiChoice)
+			if err != nil {
+				continue
+			}
+		}
+		if err := client.Ping(); err != nil {
+			continue
+		}
+
+		if apiChoice != active {
+			ct.setAPI(client, apiChoice)
+			// NOTE: the statusbar is updated in the background since the coins lock may be held while fetching
+			go ct.UpdateStatusbar(fmt.Sprintf("Switched to %s API", apiChoice))
+		}
+
+		return nil
+	}
+
+	return ErrNoAvailableAPI
+}
+
+// failoverAPI switches to the next API in preference order after the active one that responds to a ping, so a
+// failed call can be retried right away instead of after maxAPIFailures failed refreshes. It returns true if it
+// switched
+func (ct *Cointop) failoverAPI() bool {
+	ct.debuglog("failoverAPI()")
+	list := ct.APIPreference()
+	if len(list) < 2 {
+		return false
+	}
+	activeAPIChoice := ct.ActiveAPIChoice()
+	active := 0
+	for i, apiChoice := range list {
+		if apiChoice == activeAPIChoice {
+			active = i
+		}
+	}
+	for i := 1; i < len(list); i++ {
+		apiChoice := list[(active+i)%len(list)]
+		if apiChoice == CoinMarketCap && ct.apiKeys.cmc == "" && os.Getenv("CMC_PRO_API_KEY") == "" {
+			continue
+		}
+		client, err := ct.newAPI(apiChoice)
+		if err != nil {
+			continue
+		}
+		if err := client.Ping(); err != nil {
+			continue
+		}
+
+		ct.setAPI(client, apiChoice)
+		atomic.StoreInt32(&ct.apiFailures, 0)
+		// NOTE: the statusbar is updated in the background since the coins lock may be held while fetching
+		go ct.UpdateStatusbar(fmt.Sprintf("Switched to %s API", apiChoice))
+		return true
+	}
+
+	return false
+}
+
+// handleAPIResult tracks consecutive failed refreshes and selects another API after too many
+func (ct *Cointop) handleAPIResult(ok bool) {
+	if ok || len(ct.apiFallbacks) == 0 {
+		atomic.StoreInt32(&ct.apiFailures, 0)
+		return
+	}
+
+	if atomic.AddInt32(&ct.apiFailures, 1) >= maxAPIFailures {
+		atomic.StoreInt32(&ct.apiFailures, 0)
+		if err := ct.SelectAvailableAPI(); err != nil {
+			ct.debuglog(err.Error())
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsBlacklisted returns true if the coin name or ID is in the blacklist
+func (ct *Cointop) IsBlacklisted(name string, id string) bool {
+	for _, v := range ct.State.blacklist {
+		if strings.EqualFold(v, name) || strings.EqualFold(v, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// BlacklistCoin adds the highlighted coin to the blacklist and removes it from the coins list
+func (ct *Cointop) BlacklistCoin() error {
+	ct.debuglog("blacklistCoin()")
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return nil
+	}
+
+	if !ct.IsBlacklisted(coin.Name, coin.ID) {
+		ct.State.blacklist = append(ct.State.blacklist, coin.Name)
+	}
+	ct.removeBlacklistedCoins()
+	ct.UpdateStatusbar(fmt.Sprintf("Blacklisted %s", coin.Name))
+
+	go ct.UpdateTable()
+	return ct.Save()
+}
+
+// removeBlacklistedCoins removes the blacklisted coins from the coins list
+func (ct *Cointop) removeBlacklistedCoins() {
+	updatecoinsmux.Lock()
+	defer updatecoinsmux.Unlock()
+
+	// NOTE: the coins are removed from the slug map too so the list isn't refilled on the next update
+	allCoins := make([]*Coin, 0, len(ct.State.allCoins))
+	for _, coin := range ct.State.allCoins {
+		if ct.IsBlacklisted(coin.Name, coin.ID) {
+			ct.State.allCoinsSlugMap.Delete(coin.Name)
+			continue
+		}
+		allCoins = append(allCoins, coin)
+	}
+	ct.State.allCoins = allCoins
+}
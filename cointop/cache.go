@@ -1,15 +1,18 @@
 package cointop
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"time"
+
+	"github.com/miguelmota/cointop/pkg/filecache"
 )
 
 // CacheKey returns cached value given key
 func (ct *Cointop) CacheKey(key string) string {
 	ct.debuglog("CacheKey()")
-	return strings.ToLower(fmt.Sprintf("%s_%s", ct.apiChoice, key))
+	return strings.ToLower(fmt.Sprintf("%s_%s", ct.ActiveAPIChoice(), key))
 }
 
 // CacheAllCoinsSlugMap writes the coins map to the memory and disk cache
@@ -30,3 +33,15 @@ func (ct *Cointop) CacheAllCoinsSlugMap() {
 		}
 	}
 }
+
+// loadFileCache reads the disk cache item into dst. A corrupt cache item is discarded and dst is left as is
+func (ct *Cointop) loadFileCache(cachekey string, dst interface{}) {
+	if ct.filecache == nil {
+		return
+	}
+	if err := ct.filecache.Get(cachekey, dst); err != nil {
+		if errors.Is(err, filecache.ErrCorrupt) {
+			ct.debuglog(fmt.Sprintf("discarded cache %s: %s", cachekey, err))
+		}
+	}
+}
@@ -1,12 +1,14 @@
 package cointop
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/miguelmota/cointop/pkg/api/impl/coinpaprika"
 	"github.com/miguelmota/cointop/pkg/chartplot"
 	"github.com/miguelmota/cointop/pkg/timeutil"
 	"github.com/miguelmota/cointop/pkg/ui"
@@ -73,27 +75,106 @@ func (ct *Cointop) UpdateChart() error {
 	}
 
 	var body string
-	if len(ct.State.chartPoints) == 0 {
-		body = "\n\n\n\n\nnot enough data for chart"
+	if ct.IsPortfolioVisible() && ct.State.privacyMode {
+		body = ct.colorscheme.Chart("\n\n\n\n\nchart hidden in privacy mode")
+	} else if len(ct.State.chartPoints) == 0 {
+		body = ct.colorscheme.Chart("\n\n\n\n\nnot enough data for chart")
 	} else {
+		if ct.IsChartCompare() {
+			body = fmt.Sprintf("%s\n", ct.chartLegendRow())
+		}
 		for i := range ct.State.chartPoints {
 			var s string
 			for j := range ct.State.chartPoints[i] {
 				p := ct.State.chartPoints[i][j]
 				s = fmt.Sprintf("%s%c", s, p)
 			}
-			body = fmt.Sprintf("%s%s\n", body, s)
-
+			body = fmt.Sprintf("%s%s\n", body, ct.chartRow(i, s))
 		}
 	}
 
 	ct.UpdateUI(func() error {
-		return ct.Views.Chart.Update(ct.colorscheme.Chart(body))
+		return ct.Views.Chart.Update(body)
 	})
 
 	return nil
 }
 
+// chart point colors
+const (
+	chartColorDefault = iota
+	chartColorOverlay
+	chartColorCandleUp
+	chartColorCandleDown
+	// NOTE: the lines of the comparison chart are colored from chartColorSeries on
+	chartColorSeries
+)
+
+// chartRow returns the row of the chart colored with the chart color and the overlay, candle or line colors
+func (ct *Cointop) chartRow(i int, s string) string {
+	var colors []int
+	if i < len(ct.State.chartSeriesMask) {
+		for _, line := range ct.State.chartSeriesMask[i] {
+			if line < 0 {
+				colors = append(colors, chartColorDefault)
+			} else {
+				colors = append(colors, chartColorSeries+line)
+			}
+		}
+	} else if i < len(ct.State.chartCandleMask) {
+		for _, direction := range ct.State.chartCandleMask[i] {
+			switch direction {
+			case chartplot.CandleUp:
+				colors = append(colors, chartColorCandleUp)
+			case chartplot.CandleDown:
+				colors = append(colors, chartColorCandleDown)
+			default:
+				colors = append(colors, chartColorDefault)
+			}
+		}
+	} else if i < len(ct.State.chartOverlay) {
+		for _, overlay := range ct.State.chartOverlay[i] {
+			if overlay {
+				colors = append(colors, chartColorOverlay)
+			} else {
+				colors = append(colors, chartColorDefault)
+			}
+		}
+	} else {
+		return ct.colorscheme.Chart(s)
+	}
+
+	runes := []rune(s)
+	var row string
+	for start := 0; start < len(runes); {
+		end := start
+		for end < len(runes) && end < len(colors) && colors[end] == colors[start] {
+			end++
+		}
+		if end == start {
+			end = len(runes)
+		}
+		color := chartColorDefault
+		if start < len(colors) {
+			color = colors[start]
+		}
+		switch {
+		case color >= chartColorSeries:
+			row += ct.colorscheme.ChartSeries(color-chartColorSeries, string(runes[start:end]))
+		case color == chartColorOverlay:
+			row += ct.colorscheme.ChartOverlay(string(runes[start:end]))
+		case color == chartColorCandleUp:
+			row += ct.colorscheme.ChartCandleUp(string(runes[start:end]))
+		case color == chartColorCandleDown:
+			row += ct.colorscheme.ChartCandleDown(string(runes[start:end]))
+		default:
+			row += ct.colorscheme.Chart(string(runes[start:end]))
+		}
+		start = end
+	}
+	return row
+}
+
 // ChartPoints calculates the the chart points
 func (ct *Cointop) ChartPoints(symbol string, name string) error {
 	ct.debuglog("ChartPoints()")
@@ -119,13 +200,33 @@ func (ct *Cointop) ChartPoints(symbol string, name string) error {
 	start := nowseconds - int64(rangeseconds.Seconds())
 	end := nowseconds
 
+	ct.State.chartCandleMask = nil
+	ct.State.chartSeriesMask = nil
+	if ct.State.chartCompare {
+		// NOTE: the coin chart is drawn when fewer than two of the coins have data
+		if ct.chartComparePoints(start, end, maxX) {
+			return nil
+		}
+	}
+	if ct.State.chartCandles && symbol != "" && !ct.State.chartVolume {
+		// NOTE: the line chart is drawn when there are no candles for the coin or range
+		if ct.chartCandlePoints(symbol, name, start, end, maxX) {
+			return nil
+		}
+	}
+
 	var data []float64
+	times := []int64{start, end}
 
 	keyname := symbol
 	if keyname == "" {
 		keyname = "globaldata"
 	}
-	cachekey := ct.CacheKey(fmt.Sprintf("%s_%s", keyname, strings.Replace(ct.State.selectedChartRange, " ", "", -1)))
+	cachename := fmt.Sprintf("%s_%s", keyname, strings.Replace(ct.State.selectedChartRange, " ", "", -1))
+	if ct.State.chartVolume {
+		cachename = fmt.Sprintf("%s_volume", cachename)
+	}
+	cachekey := ct.CacheKey(cachename)
 
 	cached, found := ct.cache.Get(cachekey)
 	if found {
@@ -133,25 +234,47 @@ func (ct *Cointop) ChartPoints(symbol string, name string) error {
 		data, _ = cached.([]float64)
 		ct.debuglog("ct.ChartPoints() soft cache hit")
 	}
+	if cached, found := ct.cache.Get(cachekey + "_times"); found && len(data) > 0 {
+		times, _ = cached.([]int64)
+	}
 
-	if len(data) == 0 {
+	if len(data) == 0 && ct.IsOffline() {
+		ct.loadFileCache(cachekey, &data)
+	}
+
+	if len(data) == 0 && !ct.IsOffline() {
 		if symbol == "" {
 			convert := ct.State.currencyConversion
-			graphData, err := ct.api.GetGlobalMarketGraphData(convert, start, end)
+			graphData, err := ct.API().GetGlobalMarketGraphData(convert, start, end)
+			if errors.Is(err, coinpaprika.ErrNoGlobalMarketGraph) {
+				go ct.UpdateStatusbar(err.Error())
+			}
 			if err != nil {
 				return nil
 			}
-			for i := range graphData.MarketCapByAvailableSupply {
-				price := graphData.MarketCapByAvailableSupply[i][1]
+			series := graphData.MarketCapByAvailableSupply
+			if ct.State.chartVolume {
+				series = graphData.VolumeUSD
+			}
+			for i := range series {
+				price := series[i][1]
 				data = append(data, price)
 			}
+			if len(series) > 0 {
+				times = []int64{graphTimestamp(series[0][0]), graphTimestamp(series[len(series)-1][0])}
+			}
 		} else {
 			convert := ct.State.currencyConversion
-			graphData, err := ct.api.GetCoinGraphData(convert, symbol, name, start, end)
+			graphData, err := ct.API().GetCoinGraphData(convert, symbol, name, start, end)
 			if err != nil {
 				return nil
 			}
+			// NOTE: the full graph data is kept so it can be exported without refetching
+			ct.cache.Set(ct.chartGraphCacheKey(symbol), graphData, 1*time.Hour)
 			sorted := graphData.Price
+			if ct.State.chartVolume {
+				sorted = graphData.Volume
+			}
 			sort.Slice(sorted[:], func(i, j int) bool {
 				return sorted[i][0] < sorted[j][0]
 			})
@@ -159,9 +282,13 @@ func (ct *Cointop) ChartPoints(symbol string, name string) error {
 				price := sorted[i][1]
 				data = append(data, price)
 			}
+			if len(sorted) > 0 {
+				times = []int64{graphTimestamp(sorted[0][0]), graphTimestamp(sorted[len(sorted)-1][0])}
+			}
 		}
 
 		ct.cache.Set(cachekey, data, 10*time.Second)
+		ct.cache.Set(cachekey+"_times", times, 10*time.Second)
 		if ct.filecache != nil {
 			go func() {
 				ct.filecache.Set(cachekey, data, 24*time.Hour)
@@ -170,7 +297,69 @@ func (ct *Cointop) ChartPoints(symbol string, name string) error {
 	}
 
 	chart.SetData(data)
-	ct.State.chartPoints = chart.GetChartPoints(maxX)
+	ct.State.chartOverlay = nil
+	if ct.State.chartVolume {
+		ct.State.chartPoints = chart.GetBarChartPoints(maxX)
+	} else {
+		if ct.State.chartTimeAxis && len(times) == 2 {
+			chart.SetXLabels(chartTimeLabels(times[0], times[1]))
+		}
+		// NOTE: the global market chart is kept linear
+		chart.SetLogScale(ct.State.chartLogScale && symbol != "")
+		if ct.State.chartSMA {
+			// NOTE: series shorter than the period are drawn without the moving average
+			if sma := SimpleMovingAverage(data, ct.State.chartSMAPeriod); sma != nil {
+				chart.SetOverlay(sma)
+			}
+		}
+		ct.State.chartPoints = chart.GetChartPoints(maxX)
+		ct.State.chartOverlay = chart.OverlayMask()
+	}
+
+	return nil
+}
+
+// graphTimestamp returns the unix time in seconds of a graph data timestamp, which some APIs give in milliseconds
+func graphTimestamp(timestamp float64) int64 {
+	if timestamp > 1e11 {
+		return int64(timestamp / 1e3)
+	}
+	return int64(timestamp)
+}
+
+// chartTimeLabels returns the labels for the start, middle and end of the chart time range
+func chartTimeLabels(start, end int64) (string, string, string) {
+	layout := "Jan 02"
+	span := time.Duration(end-start) * time.Second
+	if span <= 48*time.Hour {
+		layout = "15:04"
+	} else if span > 366*24*time.Hour {
+		layout = "Jan 2006"
+	}
+	middle := start + (end-start)/2
+	return time.Unix(start, 0).Format(layout), time.Unix(middle, 0).Format(layout), time.Unix(end, 0).Format(layout)
+}
+
+// chartGraphCacheKey returns the cache key of the graph data of the coin for the selected chart range
+func (ct *Cointop) chartGraphCacheKey(symbol string) string {
+	return ct.CacheKey(fmt.Sprintf("%s_%s_graph", symbol, strings.Replace(ct.State.selectedChartRange, " ", "", -1)))
+}
+
+// ToggleChartVolume toggles between the price chart and the volume chart
+func (ct *Cointop) ToggleChartVolume() error {
+	ct.debuglog("toggleChartVolume()")
+	if ct.IsPortfolioVisible() {
+		ct.UpdateStatusbar("Volume chart is not available for the portfolio")
+		return nil
+	}
+
+	ct.State.chartVolume = !ct.State.chartVolume
+
+	go func() {
+		// keep these two synchronous to avoid race conditions
+		ct.ShowChartLoader()
+		ct.UpdateChart()
+	}()
 
 	return nil
 }
@@ -201,6 +390,11 @@ func (ct *Cointop) PortfolioChart() error {
 
 	var data []float64
 	portfolio := ct.GetPortfolioSlice()
+	rate, ok := ct.portfolioRate()
+	if !ok {
+		// NOTE: the chart is left empty until the rate to the portfolio currency is fetched
+		portfolio = nil
+	}
 	chartname := ct.SelectedCoinName()
 	for _, p := range portfolio {
 		// filter by selected chart if selected
@@ -226,11 +420,11 @@ func (ct *Cointop) PortfolioChart() error {
 				ct.filecache.Get(cachekey, &graphData)
 			}
 
-			if len(graphData) == 0 {
+			if len(graphData) == 0 && !ct.IsOffline() {
 				time.Sleep(2 * time.Second)
 
 				convert := ct.State.currencyConversion
-				apiGraphData, err := ct.api.GetCoinGraphData(convert, p.Symbol, p.Name, start, end)
+				apiGraphData, err := ct.API().GetCoinGraphData(convert, p.Symbol, p.Name, start, end)
 				if err != nil {
 					return err
 				}
@@ -253,7 +447,7 @@ func (ct *Cointop) PortfolioChart() error {
 		}
 
 		for i := range graphData {
-			price := graphData[i]
+			price := graphData[i] * rate
 			sum := p.Holdings * price
 			if len(data)-1 >= i {
 				data[i] += sum
@@ -263,31 +457,39 @@ func (ct *Cointop) PortfolioChart() error {
 	}
 
 	chart.SetData(data)
+	if ct.State.chartTimeAxis {
+		chart.SetXLabels(chartTimeLabels(start, end))
+	}
 	ct.State.chartPoints = chart.GetChartPoints(maxX)
+	ct.State.chartOverlay = nil
+	ct.State.chartCandleMask = nil
+	ct.State.chartSeriesMask = nil
 
 	return nil
 }
 
-// ShortenChart decreases the chart height by one row
+// ShortenChart decreases the chart height by one row. An auto sized chart keeps the height set by hand until restart
 func (ct *Cointop) ShortenChart() error {
 	ct.debuglog("ShortenChart()")
 	candidate := ct.State.chartHeight - 1
-	if candidate < 5 {
+	if candidate < MinChartHeight {
 		return nil
 	}
+	ct.State.chartHeightAuto = false
 	ct.State.chartHeight = candidate
 
 	go ct.UpdateChart()
 	return nil
 }
 
-// EnlargeChart increases the chart height by one row
+// EnlargeChart increases the chart height by one row. An auto sized chart keeps the height set by hand until restart
 func (ct *Cointop) EnlargeChart() error {
 	ct.debuglog("EnlargeChart()")
 	candidate := ct.State.chartHeight + 1
-	if candidate > 30 {
+	if candidate > MaxChartHeight {
 		return nil
 	}
+	ct.State.chartHeightAuto = false
 	ct.State.chartHeight = candidate
 
 	go ct.UpdateChart()
@@ -353,6 +555,11 @@ func (ct *Cointop) LastChartRange() error {
 // ToggleCoinChart toggles between the global chart and the coin chart
 func (ct *Cointop) ToggleCoinChart() error {
 	ct.debuglog("ToggleCoinChart()")
+	if ct.IsChartPinned() {
+		ct.UpdateStatusbar(fmt.Sprintf("Chart is pinned to %s", ct.State.pinnedCoin.Name))
+		return nil
+	}
+
 	highlightedcoin := ct.HighlightedRowCoin()
 	if ct.State.selectedCoin == highlightedcoin {
 		ct.State.selectedCoin = nil
@@ -372,6 +579,61 @@ func (ct *Cointop) ToggleCoinChart() error {
 	return nil
 }
 
+// ToggleChartPin pins the chart to the highlighted coin, or unpins it if already pinned
+func (ct *Cointop) ToggleChartPin() error {
+	ct.debuglog("ToggleChartPin()")
+	if ct.IsChartPinned() {
+		ct.State.pinnedCoin = nil
+		go ct.UpdateMarketbar()
+		return nil
+	}
+
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return nil
+	}
+	ct.State.pinnedCoin = coin
+	ct.State.selectedCoin = coin
+
+	go func() {
+		// keep these two synchronous to avoid race conditions
+		ct.ShowChartLoader()
+		ct.UpdateChart()
+	}()
+
+	// TODO: not do this (SoC)
+	go ct.UpdateMarketbar()
+
+	return nil
+}
+
+// IsChartPinned returns true if the chart is pinned to a coin
+func (ct *Cointop) IsChartPinned() bool {
+	return ct.State.pinnedCoin != nil
+}
+
+// chartTimeframeLabel returns the chart metric and range label for the chart title
+func (ct *Cointop) chartTimeframeLabel() string {
+	label := ct.State.selectedChartRange
+	if ct.IsChartCompare() && !ct.IsPortfolioVisible() {
+		return fmt.Sprintf("Compare %s", label)
+	}
+	if ct.State.chartVolume && !ct.IsPortfolioVisible() {
+		label = fmt.Sprintf("Volume %s", label)
+	}
+	if ct.State.chartCandleMask != nil && !ct.IsPortfolioVisible() {
+		label = fmt.Sprintf("OHLC %s", label)
+	}
+	if ct.IsChartLogScale() {
+		label = fmt.Sprintf("Log %s", label)
+	}
+	if ct.IsChartPinned() {
+		label = fmt.Sprintf("%s (pinned)", label)
+	}
+
+	return label
+}
+
 // ShowChartLoader shows chart loading indicator
 func (ct *Cointop) ShowChartLoader() error {
 	ct.debuglog("ShowChartLoader()")
@@ -0,0 +1,93 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/chartplot"
+)
+
+// ToggleChartCandles toggles between the line chart and the candlestick chart of the coin price
+func (ct *Cointop) ToggleChartCandles() error {
+	ct.debuglog("toggleChartCandles()")
+	if ct.IsPortfolioVisible() {
+		ct.UpdateStatusbar("Candlestick chart is not available for the portfolio")
+		return nil
+	}
+
+	ct.State.chartCandles = !ct.State.chartCandles
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go func() {
+		// keep these two synchronous to avoid race conditions
+		ct.ShowChartLoader()
+		ct.UpdateChart()
+	}()
+
+	return nil
+}
+
+// loadChartCandlesFromConfig loads the candlestick chart setting from config file to struct
+func (ct *Cointop) loadChartCandlesFromConfig() error {
+	ct.debuglog("loadChartCandlesFromConfig()")
+	if chartCandles, ok := ct.config.ChartCandles.(bool); ok {
+		ct.State.chartCandles = chartCandles
+	}
+
+	return nil
+}
+
+// chartCandlePoints sets the chart points to the candles of the coin between start and end. It returns false
+// when the API has no candles for the coin or the range so the line chart is drawn instead
+func (ct *Cointop) chartCandlePoints(symbol string, name string, start int64, end int64, width int) bool {
+	ct.debuglog("chartCandlePoints()")
+	cachekey := ct.CacheKey(fmt.Sprintf("%s_%s_ohlc", symbol, strings.Replace(ct.State.selectedChartRange, " ", "", -1)))
+
+	var candles []chartplot.Candle
+	if cached, found := ct.cache.Get(cachekey); found {
+		// cache hit
+		candles, _ = cached.([]chartplot.Candle)
+		ct.debuglog("chartCandlePoints() soft cache hit")
+	}
+
+	if len(candles) == 0 {
+		if ct.IsOffline() {
+			return false
+		}
+		ohlc, err := ct.API().GetCoinOHLCData(ct.State.currencyConversion, symbol, name, start, end)
+		if err != nil {
+			ct.debuglog(fmt.Sprintf("chartCandlePoints() %s", err))
+			return false
+		}
+		rows := ohlc.Candles
+		sort.Slice(rows, func(i, j int) bool {
+			return rows[i][0] < rows[j][0]
+		})
+		for _, row := range rows {
+			if len(row) < 5 {
+				continue
+			}
+			candles = append(candles, chartplot.Candle{
+				Open:  row[1],
+				High:  row[2],
+				Low:   row[3],
+				Close: row[4],
+			})
+		}
+		ct.cache.Set(cachekey, candles, 10*time.Second)
+	}
+
+	points, mask := chartplot.GetCandlestickChartPoints(candles, width, ct.State.chartHeight)
+	if len(points) == 0 {
+		return false
+	}
+	ct.State.chartPoints = points
+	ct.State.chartCandleMask = mask
+	ct.State.chartOverlay = nil
+
+	return true
+}
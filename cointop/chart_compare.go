@@ -0,0 +1,158 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/chartplot"
+)
+
+// ChartLegendIndicator is the prefix of each coin in the legend of the comparison chart
+const ChartLegendIndicator = "■"
+
+// ToggleChartCompare toggles plotting the charted coin and the marked coins together as percent change from the
+// start of the chart range
+func (ct *Cointop) ToggleChartCompare() error {
+	ct.debuglog("toggleChartCompare()")
+	if ct.IsPortfolioVisible() {
+		ct.UpdateStatusbar("Comparison chart is not available for the portfolio")
+		return nil
+	}
+
+	if !ct.State.chartCompare && len(ct.ChartCompareCoins()) < 2 {
+		ct.UpdateStatusbar("Mark the coins to compare with the charted coin")
+		return nil
+	}
+	ct.State.chartCompare = !ct.State.chartCompare
+
+	go func() {
+		// keep these two synchronous to avoid race conditions
+		ct.ShowChartLoader()
+		ct.UpdateChart()
+	}()
+
+	return nil
+}
+
+// ChartCompareCoins returns the coins plotted by the comparison chart, the charted coin followed by the marked
+// coins, up to the max number of lines of a chart
+func (ct *Cointop) ChartCompareCoins() []*Coin {
+	var coins []*Coin
+	if ct.State.selectedCoin != nil {
+		coins = append(coins, ct.State.selectedCoin)
+	}
+	for _, coin := range ct.MarkedCoins() {
+		if len(coins) >= chartplot.MaxLines {
+			break
+		}
+		if ct.State.selectedCoin != nil && coin.Name == ct.State.selectedCoin.Name {
+			continue
+		}
+		coins = append(coins, coin)
+	}
+	return coins
+}
+
+// IsChartCompare returns true if the chart shown is the comparison chart
+func (ct *Cointop) IsChartCompare() bool {
+	return ct.State.chartSeriesMask != nil
+}
+
+// chartComparePoints sets the chart points to the price series of the coins as percent change from the start of the
+// range. It returns false when there are fewer than two coins with data so the coin chart is drawn instead
+func (ct *Cointop) chartComparePoints(start int64, end int64, width int) bool {
+	ct.debuglog("chartComparePoints()")
+	var legend []string
+	var series [][]float64
+	for _, coin := range ct.ChartCompareCoins() {
+		data, err := ct.coinPriceSeries(coin, start, end)
+		if err != nil {
+			ct.debuglog(fmt.Sprintf("chartComparePoints() %s", err))
+			continue
+		}
+		percents := percentFromStart(data)
+		if len(percents) == 0 {
+			continue
+		}
+		legend = append(legend, coin.Symbol)
+		series = append(series, percents)
+	}
+	if len(series) < 2 {
+		return false
+	}
+
+	// NOTE: the first row of the chart is the legend
+	chart := chartplot.NewChartPlot()
+	chart.SetHeight(ct.State.chartHeight - 1)
+	chart.SetData(series[0])
+	chart.SetSeries(series[1:])
+	chart.SetYLabelFormat(func(v float64) string {
+		return fmt.Sprintf("%+.1f%%", v)
+	})
+	if ct.State.chartTimeAxis {
+		chart.SetXLabels(chartTimeLabels(start, end))
+	}
+	ct.State.chartPoints = chart.GetChartPoints(width)
+	ct.State.chartSeriesMask = chart.SeriesMask()
+	ct.State.chartLegend = legend
+	ct.State.chartOverlay = nil
+	ct.State.chartCandleMask = nil
+
+	return true
+}
+
+// chartLegendRow returns the legend of the comparison chart with each coin in the color of its line
+func (ct *Cointop) chartLegendRow() string {
+	var items []string
+	for i, symbol := range ct.State.chartLegend {
+		items = append(items, ct.colorscheme.ChartSeries(i, fmt.Sprintf("%s %s", ChartLegendIndicator, symbol)))
+	}
+	return strings.Join(items, ct.colorscheme.Chart("  "))
+}
+
+// coinPriceSeries returns the prices of the coin over the chart range, sharing the cache of the coin price chart
+func (ct *Cointop) coinPriceSeries(coin *Coin, start int64, end int64) ([]float64, error) {
+	cachekey := ct.CacheKey(fmt.Sprintf("%s_%s", coin.Symbol, strings.Replace(ct.State.selectedChartRange, " ", "", -1)))
+	if cached, found := ct.cache.Get(cachekey); found {
+		if data, _ := cached.([]float64); len(data) > 0 {
+			return data, nil
+		}
+	}
+	if ct.IsOffline() {
+		return nil, ErrOffline
+	}
+
+	graphData, err := ct.API().GetCoinGraphData(ct.State.currencyConversion, coin.Symbol, coin.Name, start, end)
+	if err != nil {
+		return nil, err
+	}
+	sorted := graphData.Price
+	sort.Slice(sorted[:], func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+	var data []float64
+	for i := range sorted {
+		data = append(data, sorted[i][1])
+	}
+	ct.cache.Set(cachekey, data, 10*time.Second)
+
+	return data, nil
+}
+
+// percentFromStart returns the percent change of each value from the first positive value. Nil is returned if no
+// value is positive
+func percentFromStart(data []float64) []float64 {
+	for i, first := range data {
+		if first <= 0 {
+			continue
+		}
+		percents := make([]float64, len(data)-i)
+		for j, v := range data[i:] {
+			percents[j] = (v/first - 1) * 100
+		}
+		return percents
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package cointop
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	types "github.com/miguelmota/cointop/pkg/api/types"
+	"github.com/miguelmota/cointop/pkg/pathutil"
+)
+
+// DefaultExportDir is the default directory chart data is exported to
+var DefaultExportDir = ":HOME:"
+
+// ChartCSVRecords returns the graph data as CSV records with a header row.
+// The series are joined by timestamp and missing values are left empty
+func ChartCSVRecords(graph types.CoinGraph) [][]string {
+	series := [][][]float64{graph.Price, graph.Volume, graph.MarketCapByAvailableSupply}
+	rows := make(map[int64][]string)
+	for i, points := range series {
+		for _, point := range points {
+			if len(point) < 2 {
+				continue
+			}
+			timestamp := int64(point[0])
+			if _, ok := rows[timestamp]; !ok {
+				rows[timestamp] = make([]string, len(series))
+			}
+			rows[timestamp][i] = strconv.FormatFloat(point[1], 'f', -1, 64)
+		}
+	}
+
+	timestamps := make([]int64, 0, len(rows))
+	for timestamp := range rows {
+		timestamps = append(timestamps, timestamp)
+	}
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+
+	records := [][]string{{"timestamp", "price", "volume", "market_cap"}}
+	for _, timestamp := range timestamps {
+		records = append(records, append([]string{strconv.FormatInt(timestamp, 10)}, rows[timestamp]...))
+	}
+	return records
+}
+
+// ExportChartCSV writes the graph data of the charted coin to a CSV file in the export directory
+func (ct *Cointop) ExportChartCSV() error {
+	ct.debuglog("exportChartCSV()")
+	symbol := ct.SelectedCoinSymbol()
+	if symbol == "" || ct.IsPortfolioVisible() {
+		ct.UpdateStatusbar("Select a coin chart to export")
+		return nil
+	}
+
+	cached, found := ct.cache.Get(ct.chartGraphCacheKey(symbol))
+	graph, ok := cached.(types.CoinGraph)
+	if !found || !ok {
+		ct.UpdateStatusbar("Chart data is not loaded yet")
+		return nil
+	}
+
+	dir := pathutil.NormalizePath(ct.State.exportDir)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	chartRange := strings.Replace(ct.State.selectedChartRange, " ", "", -1)
+	filename := fmt.Sprintf("%s_%s_%s.csv", strings.ToLower(symbol), strings.ToLower(chartRange), time.Now().Format("20060102-150405"))
+	path := filepath.Join(dir, filename)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := csv.NewWriter(f)
+	if err := w.WriteAll(ChartCSVRecords(graph)); err != nil {
+		return err
+	}
+
+	ct.UpdateStatusbar(fmt.Sprintf("Exported chart data to %s", path))
+	return nil
+}
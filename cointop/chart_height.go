@@ -0,0 +1,68 @@
+package cointop
+
+import (
+	"fmt"
+)
+
+// DefaultChartHeight is the default number of rows of the chart
+const DefaultChartHeight = 10
+
+// MinChartHeight is the least number of rows of the chart
+const MinChartHeight = 5
+
+// MaxChartHeight is the most number of rows the chart can be enlarged to
+const MaxChartHeight = 30
+
+// DefaultChartHeightPercent is the default percentage of the terminal height the chart takes when auto sized
+const DefaultChartHeightPercent = 30
+
+// autoChartHeight sets the chart height to the percentage of the terminal height, leaving at least the available
+// rows to the rest of the layout. The chart is redrawn when its height changes, eg. after the terminal is resized
+func (ct *Cointop) autoChartHeight(maxY int, available int) int {
+	height := maxY * ct.State.chartHeightPercent / 100
+	if height > available {
+		height = available
+	}
+	if height < MinChartHeight {
+		height = MinChartHeight
+	}
+	if height != ct.State.chartHeight {
+		ct.State.chartHeight = height
+		if ct.Views.Chart.HasBacking() {
+			go ct.UpdateChart()
+		}
+	}
+	return height
+}
+
+// chartHeightToToml returns the chart height setting for the config
+func (ct *Cointop) chartHeightToToml() interface{} {
+	if ct.State.chartHeightSetting == 0 {
+		return "auto"
+	}
+	return ct.State.chartHeightSetting
+}
+
+// loadChartHeightFromConfig loads the chart height settings from config file to struct
+func (ct *Cointop) loadChartHeightFromConfig() error {
+	ct.debuglog("loadChartHeightFromConfig()")
+	if valueIfc := ct.config.ChartHeight; valueIfc != nil {
+		if v, ok := valueIfc.(string); ok && v == "auto" {
+			ct.State.chartHeightSetting = 0
+		} else if v, ok := valueIfc.(int64); ok && v >= MinChartHeight && v <= MaxChartHeight {
+			ct.State.chartHeightSetting = int(v)
+			ct.State.chartHeight = int(v)
+		} else {
+			return fmt.Errorf("invalid chart_height %v. Valid values are \"auto\" or a number of rows between %d and %d", valueIfc, MinChartHeight, MaxChartHeight)
+		}
+		ct.State.chartHeightAuto = ct.State.chartHeightSetting == 0
+	}
+	if percent, ok := ct.config.ChartPercent.(int64); ok {
+		if percent < 10 || percent > 90 {
+			return fmt.Errorf("invalid chart_height_percent %d. Expected a percentage between 10 and 90", percent)
+		}
+		ct.State.chartHeightPercent = int(percent)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package cointop
+
+// ToggleChartLogScale toggles plotting the coin price chart on a log scale
+func (ct *Cointop) ToggleChartLogScale() error {
+	ct.debuglog("toggleChartLogScale()")
+	ct.State.chartLogScale = !ct.State.chartLogScale
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go ct.UpdateChart()
+	return nil
+}
+
+// IsChartLogScale returns true if the chart shown is plotted on a log scale. Only the coin price line chart is,
+// so the global market, volume, candlestick, comparison and portfolio charts stay linear
+func (ct *Cointop) IsChartLogScale() bool {
+	if !ct.State.chartLogScale || ct.State.chartVolume || ct.State.chartCandleMask != nil || ct.IsChartCompare() {
+		return false
+	}
+	return !ct.IsPortfolioVisible() && ct.SelectedCoinSymbol() != ""
+}
+
+// loadChartLogScaleFromConfig loads the chart log scale setting from config file to struct
+func (ct *Cointop) loadChartLogScaleFromConfig() error {
+	ct.debuglog("loadChartLogScaleFromConfig()")
+	if chartLogScale, ok := ct.config.ChartLogScale.(bool); ok {
+		ct.State.chartLogScale = chartLogScale
+	}
+
+	return nil
+}
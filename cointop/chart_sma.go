@@ -0,0 +1,60 @@
+package cointop
+
+import (
+	"fmt"
+	"math"
+)
+
+// DefaultChartSMAPeriod is the default number of data points averaged by the chart moving average
+const DefaultChartSMAPeriod = 20
+
+// SimpleMovingAverage returns the simple moving average of the data over the period. The first points without a
+// full period are NaN. Nil is returned if the data is shorter than the period
+func SimpleMovingAverage(data []float64, period int) []float64 {
+	if period < 1 || len(data) < period {
+		return nil
+	}
+
+	sma := make([]float64, len(data))
+	var sum float64
+	for i, v := range data {
+		sum += v
+		if i >= period {
+			sum -= data[i-period]
+		}
+		if i < period-1 {
+			sma[i] = math.NaN()
+			continue
+		}
+		sma[i] = sum / float64(period)
+	}
+	return sma
+}
+
+// ToggleChartSMA toggles showing the simple moving average on the price chart
+func (ct *Cointop) ToggleChartSMA() error {
+	ct.debuglog("toggleChartSMA()")
+	ct.State.chartSMA = !ct.State.chartSMA
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go ct.UpdateChart()
+	return nil
+}
+
+// loadChartSMAFromConfig loads the chart moving average settings from config file to struct
+func (ct *Cointop) loadChartSMAFromConfig() error {
+	ct.debuglog("loadChartSMAFromConfig()")
+	if chartSMA, ok := ct.config.ChartSMA.(bool); ok {
+		ct.State.chartSMA = chartSMA
+	}
+	if period, ok := ct.config.SMAPeriod.(int64); ok {
+		if period < 2 {
+			return fmt.Errorf("invalid chart_sma_period %d. Expected a number of data points greater than 1", period)
+		}
+		ct.State.chartSMAPeriod = int(period)
+	}
+
+	return nil
+}
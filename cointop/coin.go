@@ -2,26 +2,42 @@ package cointop
 
 // Coin is the row structure
 type Coin struct {
-	ID               string
-	Name             string
-	Slug             string
-	Symbol           string
-	Rank             int
-	Price            float64
-	Volume24H        float64
-	MarketCap        float64
-	AvailableSupply  float64
-	TotalSupply      float64
-	PercentChange1H  float64
-	PercentChange24H float64
-	PercentChange7D  float64
-	PercentChange30D float64
-	LastUpdated      string
+	ID               string  `json:"id"`
+	Name             string  `json:"name"`
+	Slug             string  `json:"slug"`
+	Symbol           string  `json:"symbol"`
+	Rank             int     `json:"rank"`
+	Price            float64 `json:"price"`
+	Volume24H        float64 `json:"volume24H"`
+	MarketCap        float64 `json:"marketCap"`
+	FDV              float64 `json:"fdv"`
+	High24H          float64 `json:"high24H"`
+	Low24H           float64 `json:"low24H"`
+	ATH              float64 `json:"ath"`
+	PercentFromATH   float64 `json:"percentFromATH"`
+	AvailableSupply  float64 `json:"availableSupply"`
+	TotalSupply      float64 `json:"totalSupply"`
+	MaxSupply        float64 `json:"maxSupply"`
+	PercentChange1H  float64 `json:"percentChange1H"`
+	PercentChange24H float64 `json:"percentChange24H"`
+	PercentChange7D  float64 `json:"percentChange7D"`
+	PercentChange30D float64 `json:"percentChange30D"`
+	LastUpdated      string  `json:"lastUpdated"`
+	DateAdded        string  `json:"dateAdded"`
+	// prices of the last 7 days for the sparkline column
+	Sparkline7D []float64 `json:"sparkline7D"`
+	// price at the previous refresh
+	PrevPrice float64 `json:"prevPrice"`
 	// for favorites
-	Favorite bool
+	Favorite bool `json:"favorite"`
 	// for portfolio
-	Holdings float64
-	Balance  float64
+	Holdings float64 `json:"holdings"`
+	Balance  float64 `json:"balance"`
+	// weighted average cost of the portfolio lots
+	CostPrice         float64 `json:"costPrice"`
+	Cost              float64 `json:"cost"`
+	ProfitLoss        float64 `json:"profitLoss"`
+	PercentProfitLoss float64 `json:"percentProfitLoss"`
 }
 
 // AllCoins returns a slice of all the coins
@@ -49,6 +65,18 @@ func (ct *Cointop) AllCoins() []*Coin {
 		return list
 	}
 
+	if ct.IsRecentlyAddedVisible() {
+		return ct.GetRecentlyAddedSlice()
+	}
+
+	if ct.IsMoversVisible() {
+		return ct.GetMoversSlice(ct.IsLosersVisible())
+	}
+
+	if ct.IsCoinsFiltered() {
+		return ct.filterCoins(ct.State.allCoins)
+	}
+
 	return ct.State.allCoins
 }
 
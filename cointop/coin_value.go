@@ -0,0 +1,161 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/fees"
+	"github.com/miguelmota/cointop/pkg/humanize"
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// DefaultValueCurrencies are the currencies shown in the coin value view by default
+var DefaultValueCurrencies = []string{"USD", "EUR", "GBP", "JPY"}
+
+// coinValueCurrencies returns the primary currency followed by the configured value currencies
+func (ct *Cointop) coinValueCurrencies() []string {
+	currencies := []string{ct.State.currencyConversion}
+	for _, currency := range ct.State.valueCurrencies {
+		currency = strings.ToUpper(currency)
+		if currency != ct.State.currencyConversion {
+			currencies = append(currencies, currency)
+		}
+	}
+	return currencies
+}
+
+// coinPriceIn returns the coin price in the currency, using the table price for the primary currency
+func (ct *Cointop) coinPriceIn(coin *Coin, currency string) (float64, error) {
+	if currency == ct.State.currencyConversion {
+		return coin.Price, nil
+	}
+
+	cachekey := ct.CacheKey(fmt.Sprintf("price_%s_%s", coin.Name, currency))
+	if cached, found := ct.cache.Get(cachekey); found {
+		if price, ok := cached.(float64); ok {
+			return price, nil
+		}
+	}
+
+	price, err := ct.API().Price(coin.Name, currency)
+	if err != nil {
+		return 0, err
+	}
+	ct.cache.Set(cachekey, price, 1*time.Minute)
+	return price, nil
+}
+
+// coinNetworkFees returns the network fee estimates of the coin chain, or nil if the chain isn't supported
+func (ct *Cointop) coinNetworkFees(coin *Coin) ([]fees.Fee, error) {
+	fetcher := fees.ForCoin(coin.Symbol)
+	if !ct.State.networkFees || fetcher == nil {
+		return nil, nil
+	}
+
+	cachekey := ct.CacheKey(fmt.Sprintf("fees_%s", strings.ToLower(coin.Symbol)))
+	if cached, found := ct.cache.Get(cachekey); found {
+		if list, ok := cached.([]fees.Fee); ok {
+			return list, nil
+		}
+	}
+
+	list, err := fetcher.Fees()
+	if err != nil {
+		return nil, err
+	}
+	ct.cache.Set(cachekey, list, 30*time.Second)
+	return list, nil
+}
+
+// UpdateCoinValue updates the coin value view with the value of the holdings, or the unit price if there are none
+func (ct *Cointop) UpdateCoinValue(coin *Coin, loading bool) error {
+	ct.debuglog("updateCoinValue()")
+	holdings := coin.Holdings
+	if entry, isNew := ct.PortfolioEntry(coin); !isNew {
+		holdings = entry.Holdings
+	}
+
+	title := "Coin Price"
+	label := fmt.Sprintf("1 %s", coin.Symbol)
+	if holdings > 0 {
+		title = "Holdings Value"
+		label = fmt.Sprintf("%s %s", ct.MaskValue(ct.FormatHoldings(holdings)), coin.Symbol)
+	}
+
+	var rows []string
+	for _, currency := range ct.coinValueCurrencies() {
+		text := "loading..."
+		if !loading {
+			price, err := ct.coinPriceIn(coin, currency)
+			if err != nil {
+				text = "unavailable"
+			} else {
+				text = fmt.Sprintf("%s%s", CurrencySymbol(currency), humanize.Commaf(price))
+				if holdings > 0 {
+					text = fmt.Sprintf("%s%s", CurrencySymbol(currency), ct.MaskValue(humanize.Commaf(price*holdings)))
+				}
+			}
+		}
+		rows = append(rows, fmt.Sprintf(" %s  %s", pad.Right(currency, 5, " "), text))
+	}
+
+	body := strings.Join(rows, "\n")
+	if ct.State.networkFees && fees.ForCoin(coin.Symbol) != nil {
+		var feeRows []string
+		if loading {
+			feeRows = append(feeRows, " loading...")
+		} else if list, err := ct.coinNetworkFees(coin); err != nil {
+			feeRows = append(feeRows, " unavailable")
+		} else {
+			for _, fee := range list {
+				feeRows = append(feeRows, fmt.Sprintf(" %s  %s", pad.Right(fee.Label, 10, " "), fee.Value))
+			}
+		}
+		body = fmt.Sprintf("%s\n\n %s\n\n%s", body, ct.colorscheme.MenuLabel("Network Fees"), strings.Join(feeRows, "\n"))
+	}
+
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" %s %s\n\n", title, pad.Left("[q] close ", ct.width()-len(title)-5, " ")))
+	content := fmt.Sprintf("%s %s\n\n%s\n\n [ESC] Close", header, ct.colorscheme.MenuLabel(label), body)
+
+	ct.UpdateUI(func() error {
+		if !ct.State.coinValueVisible {
+			return nil
+		}
+		ct.Views.Menu.SetFrame(true)
+		return ct.Views.Menu.Update(content)
+	})
+	return nil
+}
+
+// ShowCoinValue shows the value of the holdings of the highlighted coin in several currencies
+func (ct *Cointop) ShowCoinValue() error {
+	ct.debuglog("showCoinValue()")
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return nil
+	}
+
+	ct.State.coinValueVisible = true
+	ct.UpdateCoinValue(coin, true)
+	ct.SetActiveView(ct.Views.Menu.Name())
+	go ct.UpdateCoinValue(coin, false)
+	return nil
+}
+
+// HideCoinValue hides the coin value view
+func (ct *Cointop) HideCoinValue() error {
+	ct.debuglog("hideCoinValue()")
+	if !ct.State.coinValueVisible {
+		return nil
+	}
+
+	ct.State.coinValueVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		return ct.Views.Menu.Update("")
+	})
+	return nil
+}
@@ -15,15 +15,29 @@ var SupportedCoinTableHeaders = []string{
 	"name",
 	"symbol",
 	"price",
+	"24h_high",
+	"24h_low",
 	"1h_change",
 	"24h_change",
 	"7d_change",
 	"30d_change",
+	"change",
 	"24h_volume",
 	"market_cap",
+	"fdv",
+	"fdv_mcap",
+	"volume_mcap",
+	"ath",
+	"ath_change",
 	"total_supply",
 	"available_supply",
+	"circ_pct",
+	"max_supply",
+	"circ_max_pct",
+	"rs_btc",
+	"rank_change",
 	"last_updated",
+	"sparkline_7d",
 }
 
 // DefaultCoinTableHeaders are the default coin table header columns
@@ -54,7 +68,147 @@ func (ct *Cointop) ValidCoinsTableHeader(name string) bool {
 
 // GetCoinsTableHeaders returns the coins table headers
 func (ct *Cointop) GetCoinsTableHeaders() []string {
-	return ct.State.coinsTableColumns
+	return ct.withPriceCurrencyColumns(ct.State.coinsTableColumns)
+}
+
+// CirculatingSupplyPercent returns the available supply as a percent of the total supply.
+// It returns false when the total supply is unknown, which includes the case where the
+// total supply equals the available supply since some APIs fall back to the available supply.
+func CirculatingSupplyPercent(coin *Coin) (float64, bool) {
+	if coin.TotalSupply <= 0 || coin.TotalSupply == coin.AvailableSupply {
+		return 0, false
+	}
+
+	return (coin.AvailableSupply / coin.TotalSupply) * 1e2, true
+}
+
+// InfiniteSupply is shown as the max supply of coins without a supply cap
+const InfiniteSupply = "∞"
+
+// CirculatingMaxSupplyPercent returns the available supply as a percent of the max supply.
+// It returns false for coins without a max supply.
+func CirculatingMaxSupplyPercent(coin *Coin) (float64, bool) {
+	if coin.MaxSupply <= 0 {
+		return 0, false
+	}
+
+	return (coin.AvailableSupply / coin.MaxSupply) * 1e2, true
+}
+
+// ChangeWindows are the windows the change column cycles through
+var ChangeWindows = []string{"1h", "24h", "7d", "30d"}
+
+// ChangeWindowPercent returns the coin percent change for the window of the change column
+func (ct *Cointop) ChangeWindowPercent(coin *Coin) float64 {
+	switch ct.State.changeWindow {
+	case "1h":
+		return coin.PercentChange1H
+	case "7d":
+		return coin.PercentChange7D
+	case "30d":
+		return coin.PercentChange30D
+	default:
+		return coin.PercentChange24H
+	}
+}
+
+// CycleChangeWindow rotates the window of the change column through 1h, 24h, 7d and 30d
+func (ct *Cointop) CycleChangeWindow() error {
+	ct.debuglog("cycleChangeWindow()")
+	next := ChangeWindows[0]
+	for i, window := range ChangeWindows {
+		if window == ct.State.changeWindow {
+			next = ChangeWindows[(i+1)%len(ChangeWindows)]
+			break
+		}
+	}
+	ct.State.changeWindow = next
+	// NOTE: the width is reset since the label length depends on the window
+	ct.State.tableColumnWidths.Delete("change")
+
+	go ct.UpdateTable()
+	return ct.Save()
+}
+
+// VolumeValue returns the coin 24h volume in the currency, or in units of the coin when the volume column shows coin units
+func (ct *Cointop) VolumeValue(coin *Coin) float64 {
+	if !ct.State.volumeInCoin {
+		return coin.Volume24H
+	}
+	if coin.Price <= 0 {
+		return 0
+	}
+	return coin.Volume24H / coin.Price
+}
+
+// ToggleVolumeUnits toggles the volume column between the currency and units of the coin
+func (ct *Cointop) ToggleVolumeUnits() error {
+	ct.debuglog("toggleVolumeUnits()")
+	ct.State.volumeInCoin = !ct.State.volumeInCoin
+	// NOTE: the width is reset since the label length depends on the units
+	ct.State.tableColumnWidths.Delete("24h_volume")
+
+	go ct.UpdateTable()
+	return ct.Save()
+}
+
+// RelativeStrength returns the coin 7d change minus the 7d change of the benchmark coin.
+// It returns false when the benchmark coin isn't loaded yet.
+func (ct *Cointop) RelativeStrength(coin *Coin) (float64, bool) {
+	ibenchmark, ok := ct.State.allCoinsSlugMap.Load(ct.State.benchmarkCoin)
+	if !ok {
+		return 0, false
+	}
+	benchmark, ok := ibenchmark.(*Coin)
+	if !ok || benchmark == nil {
+		return 0, false
+	}
+
+	return coin.PercentChange7D - benchmark.PercentChange7D, true
+}
+
+// FDVRatio returns the fully diluted valuation divided by the market cap.
+// It returns false when either is unknown.
+func FDVRatio(coin *Coin) (float64, bool) {
+	if coin.FDV <= 0 || coin.MarketCap <= 0 {
+		return 0, false
+	}
+
+	return coin.FDV / coin.MarketCap, true
+}
+
+// VolumeMarketCapRatio returns the 24 hour volume divided by the market cap.
+// It returns false when the market cap is unknown.
+func VolumeMarketCapRatio(coin *Coin) (float64, bool) {
+	if coin.MarketCap <= 0 {
+		return 0, false
+	}
+
+	return coin.Volume24H / coin.MarketCap, true
+}
+
+// PercentFromATH returns the percent the price is below the all-time high.
+// It returns false when the API doesn't provide the all-time high.
+func PercentFromATH(coin *Coin) (float64, bool) {
+	if coin.ATH <= 0 {
+		return 0, false
+	}
+
+	return coin.PercentFromATH, true
+}
+
+// priceTickColor returns the price color for whether the price rose or fell since the previous refresh
+func (ct *Cointop) priceTickColor(coin *Coin, defaultColor func(a ...interface{}) string) func(a ...interface{}) string {
+	if !ct.State.priceTickColor || coin.PrevPrice == 0 {
+		return defaultColor
+	}
+	if coin.Price > coin.PrevPrice {
+		return ct.colorscheme.TableColumnChangeUp
+	}
+	if coin.Price < coin.PrevPrice {
+		return ct.colorscheme.TableColumnChangeDown
+	}
+	return defaultColor
 }
 
 // GetCoinsTable returns the table for diplaying the coins
@@ -66,9 +220,12 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 	if ct.IsFavoritesVisible() {
 		headers = ct.GetFavoritesTableHeaders()
 	}
+	if ct.IsRecentlyAddedVisible() {
+		headers = ct.GetRecentlyAddedTableHeaders()
+	}
 	ct.ClearSyncMap(ct.State.tableColumnWidths)
 	ct.ClearSyncMap(ct.State.tableColumnAlignLeft)
-	for _, coin := range ct.State.coins {
+	for i, coin := range ct.State.coins {
 		if coin == nil {
 			continue
 		}
@@ -79,7 +236,7 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 			switch header {
 			case "rank":
 				star := ct.colorscheme.TableRow(" ")
-				if coin.Favorite {
+				if coin.Favorite && ct.State.favoritesHighlight != FavoritesHighlightNone {
 					star = ct.colorscheme.TableRowFavorite("*")
 				}
 				rank := fmt.Sprintf("%s%v", star, ct.colorscheme.TableRow(fmt.Sprintf("%6v ", coin.Rank)))
@@ -95,8 +252,21 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 			case "name":
 				name := TruncateString(coin.Name, 16)
 				namecolor := ct.colorscheme.TableRow
+				if ct.IsRecentlyAddedVisible() && ct.IsRecentlyAdded(coin) {
+					namecolor = ct.colorscheme.TableColumnChangeUp
+				}
 				if coin.Favorite {
-					namecolor = ct.colorscheme.TableRowFavorite
+					switch ct.State.favoritesHighlight {
+					case FavoritesHighlightStar:
+						name = fmt.Sprintf("%s %s", FavoriteStar, name)
+						namecolor = ct.colorscheme.TableRowFavorite
+					case FavoritesHighlightColor:
+						namecolor = ct.colorscheme.TableRowFavorite
+					}
+				}
+				if ct.IsMarked(coin) {
+					name = fmt.Sprintf("%s %s", MarkIndicator, name)
+					namecolor = ct.colorscheme.TableColumnChangeUp
 				}
 				ct.SetTableColumnWidthFromString(header, name)
 				ct.SetTableColumnAlignLeft(header, true)
@@ -107,6 +277,18 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 					Color:       namecolor,
 					Text:        name,
 				})
+			case "group":
+				group := TruncateString(ct.favoriteGroupLabel(ct.State.coins, i), 16)
+				ct.SetTableColumnWidthFromString(header, group)
+				ct.SetTableColumnAlignLeft(header, true)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   true,
+						Color:       ct.colorscheme.TableRowFavorite,
+						Text:        group,
+					})
 			case "symbol":
 				symbol := TruncateString(coin.Symbol, 6)
 				ct.SetTableColumnWidthFromString(header, symbol)
@@ -120,7 +302,7 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 						Text:        symbol,
 					})
 			case "price":
-				text := humanize.Commaf(coin.Price)
+				text := ct.FormatPrice(coin.Price)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -128,11 +310,34 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 						LeftMargin:  leftMargin,
 						RightMargin: rightMargin,
 						LeftAlign:   false,
-						Color:       ct.colorscheme.TableColumnPrice,
+						Color:       ct.priceTickColor(coin, ct.colorscheme.TableColumnPrice),
+						Text:        text,
+					})
+			case "change":
+				percent := ct.ChangeWindowPercent(coin)
+				colorChange := ct.colorscheme.TableColumnChange
+				if percent > 0 {
+					colorChange = ct.colorscheme.TableColumnChangeUp
+				}
+				if percent < 0 {
+					colorChange = ct.colorscheme.TableColumnChangeDown
+				}
+				text := humanize.Percentf(percent, 2)
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       colorChange,
 						Text:        text,
 					})
 			case "24h_volume":
 				text := humanize.Commaf(coin.Volume24H)
+				if ct.State.volumeInCoin {
+					text = humanize.Commaf0(ct.VolumeValue(coin))
+				}
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -151,7 +356,7 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 				if coin.PercentChange1H < 0 {
 					color1h = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange1H)
+				text := humanize.Percentf(coin.PercentChange1H, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -170,7 +375,7 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 				if coin.PercentChange24H < 0 {
 					color24h = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange24H)
+				text := humanize.Percentf(coin.PercentChange24H, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -189,7 +394,7 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 				if coin.PercentChange7D < 0 {
 					color7d = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange7D)
+				text := humanize.Percentf(coin.PercentChange7D, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -200,6 +405,28 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 						Color:       color7d,
 						Text:        text,
 					})
+			case "sparkline_7d":
+				text := "-"
+				colorsparkline := ct.colorscheme.TableColumnChange
+				if values := coin.Sparkline7D; len(values) > 0 {
+					text = RenderSparkline(values, SparklineWidth)
+					if values[len(values)-1] > values[0] {
+						colorsparkline = ct.colorscheme.TableColumnChangeUp
+					}
+					if values[len(values)-1] < values[0] {
+						colorsparkline = ct.colorscheme.TableColumnChangeDown
+					}
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, true)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   true,
+						Color:       colorsparkline,
+						Text:        text,
+					})
 			case "30d_change":
 				color30d := ct.colorscheme.TableColumnChange
 				if coin.PercentChange30D > 0 {
@@ -208,7 +435,7 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 				if coin.PercentChange30D < 0 {
 					color30d = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange30D)
+				text := humanize.Percentf(coin.PercentChange30D, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -231,6 +458,104 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 						Color:       ct.colorscheme.TableRow,
 						Text:        text,
 					})
+			case "fdv":
+				text := "-"
+				if coin.FDV > 0 {
+					text = humanize.Commaf(coin.FDV)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableRow,
+						Text:        text,
+					})
+			case "fdv_mcap":
+				text := "-"
+				if ratio, ok := FDVRatio(coin); ok {
+					text = humanize.Commafd(ratio, 2) + "x"
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableRow,
+						Text:        text,
+					})
+			case "volume_mcap":
+				var text string
+				if ratio, ok := VolumeMarketCapRatio(coin); ok {
+					text = humanize.Commafd(ratio, 4)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableRow,
+						Text:        text,
+					})
+			case "24h_high", "24h_low":
+				value := coin.High24H
+				if header == "24h_low" {
+					value = coin.Low24H
+				}
+				text := ""
+				if value > 0 {
+					text = ct.FormatPrice(value)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableColumnPrice,
+						Text:        text,
+					})
+			case "ath":
+				text := "-"
+				if coin.ATH > 0 {
+					text = ct.FormatPrice(coin.ATH)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableColumnPrice,
+						Text:        text,
+					})
+			case "ath_change":
+				text := "-"
+				colorChange := ct.colorscheme.TableColumnChange
+				if percent, ok := PercentFromATH(coin); ok {
+					text = humanize.Percentf(percent, 2)
+					if percent < 0 {
+						colorChange = ct.colorscheme.TableColumnChangeDown
+					}
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       colorChange,
+						Text:        text,
+					})
 			case "total_supply":
 				text := humanize.Commaf(coin.TotalSupply)
 				ct.SetTableColumnWidthFromString(header, text)
@@ -255,6 +580,93 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 						Color:       ct.colorscheme.TableRow,
 						Text:        text,
 					})
+			case "circ_pct":
+				text := "-"
+				if percent, ok := CirculatingSupplyPercent(coin); ok {
+					text = humanize.Percentf(percent, 2)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableRow,
+						Text:        text,
+					})
+			case "max_supply":
+				text := InfiniteSupply
+				if coin.MaxSupply > 0 {
+					text = humanize.Commaf(coin.MaxSupply)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableRow,
+						Text:        text,
+					})
+			case "circ_max_pct":
+				text := "-"
+				if percent, ok := CirculatingMaxSupplyPercent(coin); ok {
+					text = humanize.Percentf(percent, 2)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableRow,
+						Text:        text,
+					})
+			case "rank_change":
+				change, ok := ct.RankChange(coin)
+				colorrank := ct.colorscheme.TableColumnChange
+				if change > 0 {
+					colorrank = ct.colorscheme.TableColumnChangeUp
+				}
+				if change < 0 {
+					colorrank = ct.colorscheme.TableColumnChangeDown
+				}
+				text := ct.FormatRankChange(change, ok)
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       colorrank,
+						Text:        text,
+					})
+			case "rs_btc":
+				text := "-"
+				colorrs := ct.colorscheme.TableColumnChange
+				if rs, ok := ct.RelativeStrength(coin); ok {
+					if rs > 0 {
+						colorrs = ct.colorscheme.TableColumnChangeUp
+					}
+					if rs < 0 {
+						colorrs = ct.colorscheme.TableColumnChangeDown
+					}
+					text = humanize.Percentf(rs, 2)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       colorrs,
+						Text:        text,
+					})
 			case "last_updated":
 				unix, _ := strconv.ParseInt(coin.LastUpdated, 10, 64)
 				lastUpdated := time.Unix(unix, 0).Format("15:04:05 Jan 02")
@@ -268,11 +680,51 @@ func (ct *Cointop) GetCoinsTable() *table.Table {
 						Color:       ct.colorscheme.TableRow,
 						Text:        lastUpdated,
 					})
+			case "date_added":
+				var dateAdded string
+				if coin.DateAdded != "" {
+					unix, _ := strconv.ParseInt(coin.DateAdded, 10, 64)
+					dateAdded = time.Unix(unix, 0).Format("15:04:05 Jan 02 2006")
+				}
+				datecolor := ct.colorscheme.TableRow
+				if ct.IsRecentlyAdded(coin) {
+					datecolor = ct.colorscheme.TableColumnChangeUp
+				}
+				ct.SetTableColumnWidthFromString(header, dateAdded)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       datecolor,
+						Text:        dateAdded,
+					})
+			default:
+				currency, ok := priceCurrencyFromColumn(header)
+				if !ok {
+					continue
+				}
+				text := "-"
+				if price, ok := ct.PriceIn(coin, currency); ok {
+					text = ct.FormatPrice(price)
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableColumnPrice,
+						Text:        text,
+					})
 			}
 		}
 		rows = append(rows, rowCells)
 	}
 
+	ct.ResolveTableColumnWidths(headers)
 	for _, row := range rows {
 		for i, header := range headers {
 			row[i].Width = ct.GetTableColumnWidth(header)
@@ -4,14 +4,18 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/miguelmota/cointop/pkg/api"
+	"github.com/miguelmota/cointop/pkg/api/impl/coingecko"
 	"github.com/miguelmota/cointop/pkg/api/types"
 	"github.com/miguelmota/cointop/pkg/cache"
+	"github.com/miguelmota/cointop/pkg/chartplot"
 	"github.com/miguelmota/cointop/pkg/filecache"
+	"github.com/miguelmota/cointop/pkg/humanize"
 	"github.com/miguelmota/cointop/pkg/pathutil"
 	"github.com/miguelmota/cointop/pkg/table"
 	"github.com/miguelmota/cointop/pkg/ui"
@@ -26,58 +30,160 @@ type Views struct {
 	Table       *TableView
 	TableHeader *TableHeaderView
 	Marketbar   *MarketbarView
+	Infobar     *InfobarView
+	Ticker      *TickerView
 	SearchField *SearchFieldView
 	Statusbar   *StatusbarView
 	Menu        *MenuView
 	Input       *InputView
+	TooSmall    *TooSmallView
 }
 
 // State is the state preferences of cointop
 type State struct {
 	allCoins           []*Coin
 	allCoinsSlugMap    sync.Map
+	rankHistory        map[string][]RankSnapshot
+	refreshRanks       map[string]*refreshRank
+	rankGeneration     int
 	cacheDir           string
+	dataDir            string
 	coins              []*Coin
 	chartPoints        [][]rune
+	chartOverlay       [][]bool
+	chartCandleMask    [][]chartplot.CandleDirection
+	chartSeriesMask    [][]int
+	chartLegend        []string
 	currencyConversion string
 	coinsTableColumns  []string
+	baseCurrency       string
+	displayCurrency    string
 	convertMenuVisible bool
+	columnMenuVisible  bool
 	defaultView        string
 
 	// DEPRECATED: favorites by 'symbol' is deprecated because of collisions.
 	favoritesBySymbol map[string]bool
 
 	favorites                  map[string]bool
+	favoriteGroups             map[string]string
+	favoriteGroupCoin          *Coin
+	favoriteGroupMenuVisible   bool
+	markedCoins                map[string]bool
 	favoritesTableColumns      []string
+	favoriteOnSearch           bool
+	favoritesSummary           bool
+	searchChoices              []string
+	searchChoiceFavorite       bool
+	searchChoiceHelp           string
+	fuzzySearch                bool
+	searchHistory              []string
+	searchHistoryIndex         int
+	searchHistorySize          int
+	saveSearchHistory          bool
+	livePrices                 bool
+	coinsFilter                *regexp.Regexp
+	filterMenuVisible          bool
+	marketCapMin               float64
+	marketCapMax               float64
+	marketCapMenuVisible       bool
+	symbolCollision            string
+	homeActions                []string
+	confirmRemoval             bool
+	confirmMessage             string
+	confirmFn                  func() error
+	removedFavorite            string
+	removedPortfolioEntry      *PortfolioEntry
+	lastRemovedFrom            string
 	helpVisible                bool
+	contextHelpVisible         bool
+	coinDebugVisible           bool
+	coinValueVisible           bool
 	hideMarketbar              bool
 	hideChart                  bool
 	hideStatusbar              bool
+	infobarVisible             bool
+	tickerVisible              bool
+	tickerOffset               int32
 	keepRowFocusOnSort         bool
+	priceTickColor             bool
 	lastSelectedRowIndex       int
+	savedPage                  int
+	savedRowIndex              int
+	positionRestored           bool
 	marketBarHeight            int
+	minLayoutWidth             int
+	minTableRows               int
+	topMoversScope             string
+	scrollOff                  int
+	benchmarkCoin              string
+	valueCurrencies            []string
+	priceCurrencies            []string
+	priceCurrencyRates         sync.Map
+	blacklist                  []string
+	chartAutoInterval          bool
+	exportDir                  string
+	networkFees                bool
+	favoritesHighlight         string
+	changeWindow               string
+	rankChangeMode             string
+	volumeInCoin               bool
+	terminalTitle              bool
+	lastTerminalTitle          string
 	page                       int
 	perPage                    int
 	portfolio                  *Portfolio
+	portfolioCurrency          string
 	portfolioUpdateMenuVisible bool
+	perPageMenuVisible         bool
+	goToRankMenuVisible        bool
 	portfolioTableColumns      []string
+	holdingsPrecision          int
+	privacyMode                bool
+	pricePrecision             int
+	thousandsSeparator         string
+	decimalSeparator           string
+	priceRoundingRules         []*PriceRoundingRule
+	priceSigDigits             int
 	refreshRate                time.Duration
+	refreshRates               RefreshRates
+	refreshOnResume            bool
+	refreshPaused              int32
+	startupCheck               bool
+	startupTimeout             time.Duration
+	offline                    bool
+	offlineForced              bool
 	running                    bool
 	searchFieldVisible         bool
 	selectedCoin               *Coin
+	pinnedCoin                 *Coin
+	chartVolume                bool
+	chartTimeAxis              bool
+	chartSMA                   bool
+	chartSMAPeriod             int
+	chartCandles               bool
+	chartLogScale              bool
+	chartCompare               bool
 	selectedChartRange         string
 	selectedView               string
 	lastSelectedView           string
 	shortcutKeys               map[string]string
 	sortDesc                   bool
 	sortBy                     string
+	viewSorts                  map[string]*ViewSort
 	tableOffsetX               int
 	onlyTable                  bool
 	tableColumnWidths          sync.Map
+	columnWidths               map[string]*ColumnWidth
 	tableColumnAlignLeft       sync.Map
 	chartHeight                int
+	chartHeightSetting         int
+	chartHeightAuto            bool
+	chartHeightPercent         int
 	priceAlerts                *PriceAlerts
 	priceAlertEditID           string
+	recentlyAdded              []string
+	moversCount                int
 }
 
 // Cointop cointop
@@ -90,19 +196,38 @@ type Cointop struct {
 	colorsDir        string
 	config           config // toml config
 	configFilepath   string
+	portfolioFile    string
+	alertsFile       string
 	api              api.Interface
 	apiChoice        string
+	apiFallbacks     []string
+	demoMode         bool
+	activeAPIChoice  string
+	apiMux           sync.RWMutex
+	apiFailures      int32
+	cmcBaseURL       string
+	cgMaxPages       int
+	cgConcurrency    int
+	cgRefreshIDs     bool
+	cgIDOverrides    map[string]string
 	chartRanges      []string
 	chartRangesMap   map[string]time.Duration
 	colorschemeName  string
 	colorscheme      *Colorscheme
+	noColor          bool
 	debug            bool
 	filecache        *filecache.FileCache
+	datacache        *filecache.FileCache
+	cacheDirEnv      string
+	dataDirEnv       string
+	dataDirFlag      string
 	forceRefresh     chan bool
 	limiter          <-chan time.Time
 	maxTableWidth    int
 	refreshMux       sync.Mutex
-	refreshTicker    *time.Ticker
+	tableTicker      *time.Ticker
+	chartTicker      *time.Ticker
+	marketbarTicker  *time.Ticker
 	saveMux          sync.Mutex
 	State            *State
 	table            *table.Table
@@ -114,6 +239,7 @@ type Cointop struct {
 type PortfolioEntry struct {
 	Coin     string
 	Holdings float64
+	Lots     []*PortfolioLot
 }
 
 // Portfolio is portfolio structure
@@ -123,25 +249,30 @@ type Portfolio struct {
 
 // PriceAlert is price alert structure
 type PriceAlert struct {
-	ID          string
-	CoinName    string
-	TargetPrice float64
-	Operator    string
-	Frequency   string
-	CreatedAt   string
-	Expired     bool
+	ID            string    `json:"id"`
+	CoinName      string    `json:"coin_name"`
+	TargetPrice   float64   `json:"target_price"`
+	Operator      string    `json:"operator"`
+	Frequency     string    `json:"frequency"`
+	CreatedAt     string    `json:"created_at"`
+	Expired       bool      `json:"expired"`
+	LastTriggered time.Time `json:"last_triggered"`
 }
 
 // PriceAlerts is price alerts structure
 type PriceAlerts struct {
-	Entries      []*PriceAlert
-	SoundEnabled bool
+	Entries       []*PriceAlert
+	Portfolio     []*PortfolioAlert
+	SoundEnabled  bool
+	NotifyDesktop bool
+	Webhook       string
 }
 
 // Config config options
 type Config struct {
 	APIChoice           string
 	CacheDir            string
+	DataDir             string
 	ColorsDir           string
 	Colorscheme         string
 	ConfigFilepath      string
@@ -151,14 +282,18 @@ type Config struct {
 	HideChart           bool
 	HideStatusbar       bool
 	NoCache             bool
+	NoColor             bool
 	OnlyTable           bool
+	Offline             bool
+	DemoMode            bool
 	RefreshRate         *uint
 	PerPage             uint
 }
 
 // APIKeys is api keys structure
 type APIKeys struct {
-	cmc string
+	cmc       string
+	etherscan string
 }
 
 // DefaultPerPage ...
@@ -171,11 +306,38 @@ var DefaultColorscheme = "cointop"
 var DefaultConfigFilepath = pathutil.NormalizePath(":PREFERRED_CONFIG_HOME:/cointop/config.toml")
 
 // DefaultCacheDir ...
-var DefaultCacheDir = filecache.DefaultCacheDir
+var DefaultCacheDir = pathutil.NormalizePath(":PREFERRED_CACHE_HOME:/cointop")
+
+// LegacyCacheDir is the cache directory used by previous versions of cointop
+var LegacyCacheDir = filecache.DefaultCacheDir
+
+// DefaultDataDir is the directory of the portfolio and price alerts files and the rank history
+var DefaultDataDir = pathutil.NormalizePath(":PREFERRED_DATA_HOME:/cointop")
+
+// DefaultCoinGeckoMaxPages ...
+var DefaultCoinGeckoMaxPages = coingecko.DefaultMaxPages
+
+// DefaultCoinGeckoBatchConcurrency ...
+var DefaultCoinGeckoBatchConcurrency = coingecko.DefaultBatchConcurrency
+
+// MaxCoinGeckoBatchConcurrency is the highest number of CoinGecko requests of a batch fetch made at the same time
+var MaxCoinGeckoBatchConcurrency = coingecko.MaxBatchConcurrency
 
 // DefaultColorsDir ...
 var DefaultColorsDir = fmt.Sprintf("%s/colors", DefaultConfigFilepath)
 
+// resolveConfigFilepath returns the config filepath, falling back to the COINTOP_CONFIG environment variable and
+// then the default config filepath when it's empty
+func resolveConfigFilepath(path string) string {
+	if path != "" {
+		return path
+	}
+	if path := os.Getenv("COINTOP_CONFIG"); path != "" {
+		return path
+	}
+	return DefaultConfigFilepath
+}
+
 // NewCointop initializes cointop
 func NewCointop(config *Config) (*Cointop, error) {
 	var debug bool
@@ -187,13 +349,13 @@ func NewCointop(config *Config) (*Cointop, error) {
 		config = &Config{}
 	}
 
-	configFilepath := DefaultConfigFilepath
-	if config.ConfigFilepath != "" {
-		configFilepath = config.ConfigFilepath
-	}
+	configFilepath := resolveConfigFilepath(config.ConfigFilepath)
 
 	perPage := DefaultPerPage
 	if config.PerPage != 0 {
+		if err := validatePerPage(int(config.PerPage)); err != nil {
+			return nil, fmt.Errorf("invalid per-page %d. %v", config.PerPage, err)
+		}
 		perPage = config.PerPage
 	}
 
@@ -201,6 +363,9 @@ func NewCointop(config *Config) (*Cointop, error) {
 		// defaults
 		apiChoice:      CoinGecko,
 		apiKeys:        new(APIKeys),
+		cgMaxPages:     DefaultCoinGeckoMaxPages,
+		cgConcurrency:  DefaultCoinGeckoBatchConcurrency,
+		cgRefreshIDs:   true,
 		forceRefresh:   make(chan bool),
 		maxTableWidth:  175,
 		ActionsMap:     ActionsMap(),
@@ -212,38 +377,76 @@ func NewCointop(config *Config) (*Cointop, error) {
 		chartRangesMap: ChartRangesMap(),
 		limiter:        time.Tick(2 * time.Second),
 		filecache:      nil,
+		cacheDirEnv:    os.Getenv("COINTOP_CACHE_DIR"),
+		dataDirEnv:     os.Getenv("COINTOP_DATA_DIR"),
+		dataDirFlag:    config.DataDir,
+		demoMode:       config.DemoMode,
 		State: &State{
 			allCoins:           []*Coin{},
 			cacheDir:           DefaultCacheDir,
+			dataDir:            DefaultDataDir,
 			coinsTableColumns:  DefaultCoinTableHeaders,
 			currencyConversion: "USD",
+			baseCurrency:       "BTC",
 			// DEPRECATED: favorites by 'symbol' is deprecated because of collisions. Kept for backward compatibility.
 			favoritesBySymbol:     make(map[string]bool),
 			favorites:             make(map[string]bool),
+			favoriteGroups:        make(map[string]string),
+			markedCoins:           make(map[string]bool),
 			favoritesTableColumns: DefaultCoinTableHeaders,
 			hideMarketbar:         config.HideMarketbar,
 			hideChart:             config.HideChart,
 			hideStatusbar:         config.HideStatusbar,
 			keepRowFocusOnSort:    false,
+			priceTickColor:        false,
 			marketBarHeight:       1,
+			minLayoutWidth:        40,
+			minTableRows:          3,
+			topMoversScope:        TopMoversScopeAll,
+			moversCount:           DefaultMoversCount,
+			benchmarkCoin:         "Bitcoin",
+			changeWindow:          "24h",
+			rankChangeMode:        RankChangeDay,
+			chartAutoInterval:     true,
+			exportDir:             DefaultExportDir,
+			favoritesHighlight:    FavoritesHighlightColor,
+			symbolCollision:       SymbolCollisionAsk,
+			searchHistorySize:     DefaultSearchHistorySize,
+			homeActions:           DefaultHomeActions,
+			thousandsSeparator:    humanize.DefaultThousandsSeparator,
+			decimalSeparator:      humanize.DefaultDecimalSeparator,
+			valueCurrencies:       DefaultValueCurrencies,
 			onlyTable:             config.OnlyTable,
 			refreshRate:           60 * time.Second,
+			refreshOnResume:       true,
+			startupTimeout:        DefaultStartupTimeout,
+			offline:               config.Offline,
+			offlineForced:         config.Offline,
 			selectedChartRange:    "1Y",
 			shortcutKeys:          DefaultShortcuts(),
 			sortBy:                "rank",
+			viewSorts:             DefaultViewSorts(),
 			page:                  0,
 			perPage:               int(perPage),
 			portfolio: &Portfolio{
 				Entries: make(map[string]*PortfolioEntry),
 			},
 			portfolioTableColumns: DefaultPortfolioTableHeaders,
-			chartHeight:           10,
+			holdingsPrecision:     HoldingsPrecisionFull,
+			pricePrecision:        PricePrecisionAuto,
+			priceRoundingRules:    DefaultPriceRoundingRules(),
+			priceSigDigits:        DefaultPriceSignificantDigits,
+			chartHeight:           DefaultChartHeight,
+			chartHeightSetting:    DefaultChartHeight,
+			chartHeightPercent:    DefaultChartHeightPercent,
+			chartSMAPeriod:        DefaultChartSMAPeriod,
 			tableOffsetX:          0,
 			tableColumnWidths:     sync.Map{},
 			tableColumnAlignLeft:  sync.Map{},
 			priceAlerts: &PriceAlerts{
-				Entries:      make([]*PriceAlert, 0),
-				SoundEnabled: true,
+				Entries:       make([]*PriceAlert, 0),
+				SoundEnabled:  true,
+				NotifyDesktop: true,
 			},
 		},
 		TableColumnOrder: TableColumnOrder(),
@@ -252,10 +455,13 @@ func NewCointop(config *Config) (*Cointop, error) {
 			Table:       NewTableView(),
 			TableHeader: NewTableHeaderView(),
 			Marketbar:   NewMarketbarView(),
+			Infobar:     NewInfobarView(),
+			Ticker:      NewTickerView(),
 			SearchField: NewSearchFieldView(),
 			Statusbar:   NewStatusbarView(),
 			Menu:        NewMenuView(),
 			Input:       NewInputView(),
+			TooSmall:    NewTooSmallView(),
 		},
 	}
 
@@ -264,6 +470,10 @@ func NewCointop(config *Config) (*Cointop, error) {
 		return nil, err
 	}
 
+	if config.PerPage != 0 && config.PerPage != DefaultPerPage {
+		ct.State.perPage = int(config.PerPage)
+	}
+
 	ct.cache.Set("onlyTable", ct.State.onlyTable, cache.NoExpiration)
 	ct.cache.Set("hideMarketbar", ct.State.hideMarketbar, cache.NoExpiration)
 	ct.cache.Set("hideChart", ct.State.hideChart, cache.NoExpiration)
@@ -273,21 +483,27 @@ func NewCointop(config *Config) (*Cointop, error) {
 		ct.State.refreshRate = time.Duration(*config.RefreshRate) * time.Second
 	}
 
-	if ct.State.refreshRate == 0 {
-		ct.refreshTicker = time.NewTicker(time.Duration(1))
-		ct.refreshTicker.Stop()
-	} else {
-		ct.refreshTicker = time.NewTicker(ct.State.refreshRate)
-	}
+	ct.tableTicker = newRefreshTicker(ct.viewRefreshRate(ct.State.refreshRates.Table))
+	ct.chartTicker = newRefreshTicker(ct.viewRefreshRate(ct.State.refreshRates.Chart))
+	ct.marketbarTicker = newRefreshTicker(ct.viewRefreshRate(ct.State.refreshRates.Marketbar))
 
 	if config.CacheDir != "" {
 		ct.State.cacheDir = pathutil.NormalizePath(config.CacheDir)
+		ct.cacheDirEnv = ""
 		if err := ct.SaveConfig(); err != nil {
 			return nil, err
 		}
 	}
 
-	if !config.NoCache {
+	// NOTE: the data dir flag is applied when loading the config since the portfolio and price alerts files are read from it
+	if config.DataDir != "" {
+		ct.dataDirEnv = ""
+		if err := ct.SaveConfig(); err != nil {
+			return nil, err
+		}
+	}
+
+	if !config.NoCache && !ct.demoMode {
 		fcache, err := filecache.NewFileCache(&filecache.Config{
 			CacheDir: ct.State.cacheDir,
 		})
@@ -298,6 +514,16 @@ func NewCointop(config *Config) (*Cointop, error) {
 		ct.filecache = fcache
 	}
 
+	if !ct.demoMode {
+		dcache, err := filecache.NewFileCache(&filecache.Config{
+			CacheDir: ct.State.dataDir,
+		})
+		if err != nil {
+			fmt.Printf("error: %s\nyou may change the data directory with --data-dir flag.\nproceeding without saving the rank history.\n", err)
+		}
+		ct.datacache = dcache
+	}
+
 	// prompt for CoinMarketCap api key if not found
 	if config.CoinMarketCapAPIKey != "" {
 		ct.apiKeys.cmc = config.CoinMarketCapAPIKey
@@ -314,7 +540,11 @@ func NewCointop(config *Config) (*Cointop, error) {
 	if err != nil {
 		return nil, err
 	}
-	ct.colorscheme = NewColorscheme(colors)
+	if config.NoColor || ct.noColor || os.Getenv("NO_COLOR") != "" {
+		ct.colorscheme = NewMonochromeColorscheme(colors)
+	} else {
+		ct.colorscheme = NewColorscheme(colors)
+	}
 
 	if config.APIChoice != "" {
 		ct.apiChoice = config.APIChoice
@@ -323,6 +553,14 @@ func NewCointop(config *Config) (*Cointop, error) {
 		}
 	}
 
+	// NOTE: demo mode uses the fixture data instead of the configured APIs
+	if ct.demoMode {
+		ct.apiChoice = Demo
+		ct.apiFallbacks = nil
+		ct.State.offline = false
+		ct.State.offlineForced = false
+	}
+
 	if ct.apiChoice == CoinMarketCap && ct.apiKeys.cmc == "" {
 		apiKey := os.Getenv("CMC_PRO_API_KEY")
 		if apiKey == "" {
@@ -343,30 +581,51 @@ func NewCointop(config *Config) (*Cointop, error) {
 		}
 	}
 
-	if ct.apiChoice == CoinMarketCap {
-		ct.api = api.NewCMC(ct.apiKeys.cmc)
-	} else if ct.apiChoice == CoinGecko {
-		ct.api = api.NewCG()
-	} else {
-		return nil, ErrInvalidAPIChoice
+	client, err := ct.newAPI(ct.apiChoice)
+	if err != nil {
+		return nil, err
+	}
+	ct.setAPI(client, "")
+
+	// NOTE: a failed startup check starts in offline mode with the cached data instead of waiting on the network
+	if !ct.State.offline && ct.State.startupCheck {
+		if err := ct.pingAPI(ct.State.startupTimeout); err != nil {
+			ct.debuglog(err.Error())
+			ct.State.offline = true
+		}
+	}
+
+	if len(ct.apiFallbacks) > 0 && !ct.State.offline {
+		if err := ct.API().Ping(); err != nil {
+			ct.SelectAvailableAPI()
+		}
 	}
 
 	allCoinsSlugMap := make(map[string]*Coin)
 	coinscachekey := ct.CacheKey("allCoinsSlugMap")
-	if ct.filecache != nil {
-		ct.filecache.Get(coinscachekey, &allCoinsSlugMap)
+	ct.loadFileCache(coinscachekey, &allCoinsSlugMap)
+	if ct.demoMode {
+		if err := ct.loadDemoCoins(allCoinsSlugMap); err != nil {
+			return nil, err
+		}
 	}
 
 	// fix for https://github.com/miguelmota/cointop/issues/59
 	// can remove this after everyone has cleared their cache
-	for _, v := range allCoinsSlugMap {
+	var unranked []*Coin
+	for k, v := range allCoinsSlugMap {
+		if ct.IsBlacklisted(v.Name, v.ID) {
+			delete(allCoinsSlugMap, k)
+			continue
+		}
 		// Some APIs returns rank 0 for new coins
 		// or coins with low market cap data so we need to put them
 		// at the end of the list.
 		if v.Rank == 0 {
-			v.Rank = 10000
+			unranked = append(unranked, v)
 		}
 	}
+	RankUnrankedCoins(unranked)
 
 	for k, v := range allCoinsSlugMap {
 		ct.State.allCoinsSlugMap.Store(k, v)
@@ -390,39 +649,35 @@ func NewCointop(config *Config) (*Cointop, error) {
 
 	// DEPRECATED: favorites by 'symbol' is deprecated because of collisions. Kept for backward compatibility.
 	// Here we're doing a lookup based on symbol and setting the favorite to the coin name instead of coin symbol.
-	ct.State.allCoinsSlugMap.Range(func(key, value interface{}) bool {
-		if coin, ok := value.(*Coin); ok {
-			for k := range ct.State.favoritesBySymbol {
-				if coin.Symbol == k {
-					ct.State.favorites[coin.Name] = true
-					delete(ct.State.favoritesBySymbol, k)
+	// A symbol shared by several coins is resolved to the highest ranked coin.
+	for k := range ct.State.favoritesBySymbol {
+		var best *Coin
+		ct.State.allCoinsSlugMap.Range(func(key, value interface{}) bool {
+			if coin, ok := value.(*Coin); ok && coin.Symbol == k {
+				if best == nil || coin.Rank < best.Rank {
+					best = coin
 				}
 			}
+			return true
+		})
+		if best != nil {
+			ct.State.favorites[best.Name] = true
+			delete(ct.State.favoritesBySymbol, k)
 		}
+	}
 
-		return true
-	})
+	ct.loadRankHistory()
 
 	var globaldata []float64
 	chartcachekey := ct.CacheKey(fmt.Sprintf("%s_%s", "globaldata", strings.Replace(ct.State.selectedChartRange, " ", "", -1)))
-	if ct.filecache != nil {
-		ct.filecache.Get(chartcachekey, &globaldata)
-	}
+	ct.loadFileCache(chartcachekey, &globaldata)
 	ct.cache.Set(chartcachekey, globaldata, 10*time.Second)
 
 	var market types.GlobalMarketData
 	marketcachekey := ct.CacheKey("market")
-	if ct.filecache != nil {
-		ct.filecache.Get(marketcachekey, &market)
-	}
+	ct.loadFileCache(marketcachekey, &market)
 	ct.cache.Set(marketcachekey, market, 10*time.Second)
 
-	// TODO: notify offline status in status bar
-	/*
-		if err := ct.api.Ping(); err != nil {
-			return nil, err
-		}
-	*/
 	return ct, nil
 }
 
@@ -449,10 +704,17 @@ func (ct *Cointop) Run() error {
 	}
 
 	go ct.PriceAlertWatcher()
+	go ct.TickerWatcher()
+	go ct.LivePricesWatcher()
+	ct.SaveTerminalTitle()
+	defer ct.RestoreTerminalTitle()
 	ct.State.running = true
 	if err := ui.MainLoop(); err != nil && err != gocui.ErrQuit {
 		return fmt.Errorf("main loop: %v", err)
 	}
+	if err := ct.saveTablePosition(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -477,11 +739,23 @@ func Clean(config *CleanConfig) error {
 	cacheCleaned := false
 
 	cacheDir := DefaultCacheDir
+	if dir := os.Getenv("COINTOP_CACHE_DIR"); dir != "" {
+		cacheDir = pathutil.NormalizePath(dir)
+	}
 	if config.CacheDir != "" {
 		cacheDir = pathutil.NormalizePath(config.CacheDir)
 	}
 
-	if _, err := os.Stat(cacheDir); !os.IsNotExist(err) {
+	// NOTE: the cache files of previous versions are also removed from the legacy cache directory
+	cacheDirs := []string{cacheDir}
+	if cacheDir != LegacyCacheDir {
+		cacheDirs = append(cacheDirs, LegacyCacheDir)
+	}
+
+	for _, cacheDir := range cacheDirs {
+		if _, err := os.Stat(cacheDir); os.IsNotExist(err) {
+			continue
+		}
 		files, err := ioutil.ReadDir(cacheDir)
 		if err != nil {
 			return err
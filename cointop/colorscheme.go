@@ -3,6 +3,7 @@ package cointop
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 
 	fcolor "github.com/fatih/color"
@@ -26,6 +27,7 @@ type Colorscheme struct {
 	colors     colorschemeColors
 	cache      colorCache
 	cacheMutex sync.RWMutex
+	monochrome bool
 }
 
 var fgcolorschemeColorsMap = map[string]fcolor.Attribute{
@@ -70,6 +72,24 @@ func NewColorscheme(colors colorschemeColors) *Colorscheme {
 	}
 }
 
+// NewMonochromeColorscheme returns a colorscheme without any fg or bg colors.
+// Bold and underline styles are kept, favorites are bold and the selected row is inverse.
+func NewMonochromeColorscheme(colors colorschemeColors) *Colorscheme {
+	monochromeColors := make(colorschemeColors)
+	for k, v := range colors {
+		if strings.HasSuffix(k, "_fg") || strings.HasSuffix(k, "_bg") {
+			continue
+		}
+		monochromeColors[k] = v
+	}
+	monochromeColors["table_row_favorite_bold"] = true
+	monochromeColors["table_header_column_active_underline"] = true
+
+	c := NewColorscheme(monochromeColors)
+	c.monochrome = true
+	return c
+}
+
 // BaseFg ...
 func (c *Colorscheme) BaseFg() gocui.Attribute {
 	return c.gocuiFgColor("base")
@@ -85,6 +105,50 @@ func (c *Colorscheme) Chart(a ...interface{}) string {
 	return c.color("chart", a...)
 }
 
+// ChartOverlay returns the color of a chart overlay such as the moving average, or the menu label color
+// for colorschemes that don't set one
+func (c *Colorscheme) ChartOverlay(a ...interface{}) string {
+	if _, ok := c.colors["chart_overlay_fg"]; !ok {
+		return c.color("menu_label", a...)
+	}
+	return c.color("chart_overlay", a...)
+}
+
+// ChartCandleUp returns the color of a candle that closed higher, or the table change up color for colorschemes
+// that don't set one
+func (c *Colorscheme) ChartCandleUp(a ...interface{}) string {
+	if _, ok := c.colors["chart_candle_up_fg"]; !ok {
+		return c.color("table_column_change_up", a...)
+	}
+	return c.color("chart_candle_up", a...)
+}
+
+// ChartCandleDown returns the color of a candle that closed lower, or the table change down color for
+// colorschemes that don't set one
+func (c *Colorscheme) ChartCandleDown(a ...interface{}) string {
+	if _, ok := c.colors["chart_candle_down_fg"]; !ok {
+		return c.color("table_column_change_down", a...)
+	}
+	return c.color("chart_candle_down", a...)
+}
+
+// ChartSeries returns the color of the line of the ith coin of the comparison chart. Colorschemes that don't set
+// the line colors cycle through the chart, overlay, table change and active marketbar label colors
+func (c *Colorscheme) ChartSeries(i int, a ...interface{}) string {
+	name := fmt.Sprintf("chart_series_%d", i+1)
+	if _, ok := c.colors[name+"_fg"]; ok {
+		return c.color(name, a...)
+	}
+	fallbacks := []func(a ...interface{}) string{
+		c.Chart,
+		c.ChartOverlay,
+		c.TableColumnChangeUp,
+		c.TableColumnChangeDown,
+		c.MarketBarLabelActive,
+	}
+	return fallbacks[i%len(fallbacks)](a...)
+}
+
 // Marketbar ...
 func (c *Colorscheme) Marketbar(a ...interface{}) string {
 	return c.color("marketbar", a...)
@@ -276,6 +340,9 @@ func (c *Colorscheme) color(name string, a ...interface{}) string {
 }
 
 func (c *Colorscheme) gocuiFgColor(name string) gocui.Attribute {
+	if c.monochrome && name == "table_row_active" {
+		return gocui.ColorDefault | gocui.AttrReverse
+	}
 	if v, ok := c.colors[name+"_fg"].(string); ok {
 		if fg, ok := c.toGocuiAttr(v); ok {
 			return fg
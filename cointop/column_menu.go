@@ -0,0 +1,164 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// columnMenuKeys are the keys of the column menu options. 'q' is left out since it closes the menu
+var columnMenuKeys = func() []rune {
+	var keys []rune
+	for _, r := range alphanumericcharacters {
+		if r != 'q' {
+			keys = append(keys, r)
+		}
+	}
+	return keys
+}()
+
+// ShowColumnMenu shows the menu to show or hide the columns of the coins table
+func (ct *Cointop) ShowColumnMenu() error {
+	ct.debuglog("showColumnMenu()")
+	if ct.State.selectedView != CoinsView {
+		ct.UpdateStatusbar("Columns can only be chosen for the coins table")
+		return nil
+	}
+
+	ct.State.columnMenuVisible = true
+	ct.UpdateColumnMenu()
+	ct.SetActiveView(ct.Views.Menu.Name())
+	return nil
+}
+
+// HideColumnMenu hides the coins table column menu
+func (ct *Cointop) HideColumnMenu() error {
+	ct.debuglog("hideColumnMenu()")
+	if !ct.State.columnMenuVisible {
+		return nil
+	}
+
+	ct.State.columnMenuVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		return ct.Views.Menu.Update("")
+	})
+	return nil
+}
+
+// UpdateColumnMenu updates the coins table column menu with a checkbox for each supported column
+func (ct *Cointop) UpdateColumnMenu() error {
+	ct.debuglog("updateColumnMenu()")
+	title := "Columns"
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" %s %s\n\n", title, pad.Left("[q] close ", ct.width()-len(title)-5, " ")))
+	helpline := " Press the corresponding key to show or hide the column\n\n"
+	var rows []string
+	for i, name := range SupportedCoinTableHeaders {
+		check := " "
+		label := ct.colorscheme.MenuLabel(name)
+		if ct.hasCoinsTableColumn(name) {
+			check = ct.colorscheme.MenuLabelActive("x")
+			label = ct.colorscheme.Menu(name)
+		}
+		rows = append(rows, fmt.Sprintf(" [ %c ] [%s] %s", columnMenuKeys[i], check, label))
+	}
+	content := fmt.Sprintf("%s%s%s", header, helpline, strings.Join(rows, "\n"))
+
+	ct.UpdateUI(func() error {
+		if !ct.State.columnMenuVisible {
+			return nil
+		}
+		ct.Views.Menu.SetFrame(true)
+		return ct.Views.Menu.Update(content)
+	})
+	return nil
+}
+
+// ToggleColumnFn returns the function that shows or hides the column at the position in the column menu
+func (ct *Cointop) ToggleColumnFn(i int) func() error {
+	return func() error {
+		// NOTE: the option keys are bound on the menu view which other menus use too
+		if !ct.State.columnMenuVisible || i >= len(SupportedCoinTableHeaders) {
+			return nil
+		}
+		if err := ct.ToggleCoinsTableColumn(SupportedCoinTableHeaders[i]); err != nil {
+			return err
+		}
+		return ct.UpdateColumnMenu()
+	}
+}
+
+// ToggleCoinsTableColumn hides the column of the coins table if it's shown and shows it otherwise. A shown column
+// is placed by the table column order
+func (ct *Cointop) ToggleCoinsTableColumn(name string) error {
+	ct.debuglog("toggleCoinsTableColumn()")
+	if !ct.ValidCoinsTableHeader(name) {
+		return fmt.Errorf("invalid table header name %q", name)
+	}
+
+	var columns []string
+	if ct.hasCoinsTableColumn(name) {
+		if len(ct.State.coinsTableColumns) == 1 {
+			ct.UpdateStatusbar("The last column can't be hidden")
+			return nil
+		}
+		for _, col := range ct.State.coinsTableColumns {
+			if col != name {
+				columns = append(columns, col)
+			}
+		}
+	} else {
+		idx := ct.columnOrderIndex(name)
+		inserted := false
+		for _, col := range ct.State.coinsTableColumns {
+			if !inserted && ct.columnOrderIndex(col) > idx {
+				columns = append(columns, name)
+				inserted = true
+			}
+			columns = append(columns, col)
+		}
+		if !inserted {
+			columns = append(columns, name)
+		}
+	}
+	ct.State.coinsTableColumns = columns
+	if name == "sparkline_7d" {
+		ct.syncAPISparkline(ct.API())
+		// NOTE: the coins are fetched again so the sparklines show without waiting for the next refresh
+		if ct.IsSparklineVisible() {
+			ct.RefreshTableData()
+		}
+	}
+
+	go ct.UpdateTable()
+	return ct.SaveConfig()
+}
+
+// hasCoinsTableColumn returns true if the coins table shows the column
+func (ct *Cointop) hasCoinsTableColumn(name string) bool {
+	for _, col := range ct.State.coinsTableColumns {
+		if col == name {
+			return true
+		}
+	}
+	return false
+}
+
+// columnOrderIndex returns the position of the column in the table column order
+func (ct *Cointop) columnOrderIndex(name string) float64 {
+	for i, col := range ct.TableColumnOrder {
+		if col == name {
+			return float64(i)
+		}
+	}
+	// NOTE: a column missing from the order goes after the column listed before it in the supported columns
+	for i, col := range SupportedCoinTableHeaders {
+		if col == name && i > 0 {
+			return ct.columnOrderIndex(SupportedCoinTableHeaders[i-1]) + 0.5
+		}
+	}
+	return float64(len(ct.TableColumnOrder))
+}
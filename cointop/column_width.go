@@ -0,0 +1,127 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ColumnWidth is a configured column width, either a number of characters or a percentage of the available width
+type ColumnWidth struct {
+	Width   int
+	Percent bool
+}
+
+// ParseColumnWidth parses a column width such as "12" or "20%"
+func ParseColumnWidth(value string) (*ColumnWidth, error) {
+	value = strings.TrimSpace(value)
+	percent := strings.HasSuffix(value, "%")
+	width, err := strconv.Atoi(strings.TrimSuffix(value, "%"))
+	if err != nil || width <= 0 || (percent && width > 100) {
+		return nil, fmt.Errorf("invalid column width %q. Valid values are a number of characters such as \"12\" or a percentage such as \"20%%\"", value)
+	}
+	return &ColumnWidth{
+		Width:   width,
+		Percent: percent,
+	}, nil
+}
+
+// String returns the column width as it's written in the config
+func (w *ColumnWidth) String() string {
+	if w.Percent {
+		return fmt.Sprintf("%d%%", w.Width)
+	}
+	return strconv.Itoa(w.Width)
+}
+
+// ResolveTableColumnWidths applies the configured column widths to the table columns. Fixed widths are applied
+// first and the percentages are of the width left over by the other columns. A column is never made narrower than its content
+func (ct *Cointop) ResolveTableColumnWidths(headers []string) {
+	if len(ct.State.columnWidths) == 0 {
+		return
+	}
+
+	available := ct.width()
+	var percentHeaders []string
+	totalPercent := 0
+	for _, header := range headers {
+		// NOTE: each cell has a margin of one on both sides
+		available -= 2
+		columnWidth, ok := ct.State.columnWidths[header]
+		if ok && columnWidth.Percent {
+			percentHeaders = append(percentHeaders, header)
+			totalPercent += columnWidth.Width
+			continue
+		}
+		if ok {
+			ct.SetTableColumnWidth(header, columnWidth.Width)
+		}
+		available -= ct.GetTableColumnWidth(header)
+	}
+	if available <= 0 || len(percentHeaders) == 0 {
+		return
+	}
+
+	remaining := available
+	for _, header := range percentHeaders {
+		width := available * ct.State.columnWidths[header].Width / 100
+		remaining -= width
+		ct.SetTableColumnWidth(header, width)
+	}
+
+	// NOTE: give the width lost to rounding to the last column so the table fills the terminal
+	if totalPercent >= 100 && remaining > 0 {
+		last := percentHeaders[len(percentHeaders)-1]
+		ct.SetTableColumnWidth(last, ct.GetTableColumnWidth(last)+remaining)
+	}
+}
+
+// columnWidthsToToml returns the configured column widths as config tuples
+func (ct *Cointop) columnWidthsToToml() [][]string {
+	var columnWidthsIfc [][]string
+	for header, columnWidth := range ct.State.columnWidths {
+		columnWidthsIfc = append(columnWidthsIfc, []string{header, columnWidth.String()})
+	}
+	sort.Slice(columnWidthsIfc, func(i, j int) bool {
+		return columnWidthsIfc[i][0] < columnWidthsIfc[j][0]
+	})
+	return columnWidthsIfc
+}
+
+// loadColumnWidthsFromConfig loads the column widths from the config tuples
+func (ct *Cointop) loadColumnWidthsFromConfig(valueIfc interface{}) error {
+	ct.debuglog("loadColumnWidthsFromConfig()")
+	columnWidthsIfc, ok := valueIfc.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	columnWidths := map[string]*ColumnWidth{}
+	totalPercent := 0
+	for _, itemIfc := range columnWidthsIfc {
+		tupleIfc, ok := itemIfc.([]interface{})
+		if !ok || len(tupleIfc) != 2 {
+			continue
+		}
+		header, _ := tupleIfc[0].(string)
+		value, _ := tupleIfc[1].(string)
+		if _, ok := headerColumn(header); !ok {
+			return fmt.Errorf("invalid column_widths column %q", header)
+		}
+		columnWidth, err := ParseColumnWidth(value)
+		if err != nil {
+			return err
+		}
+		if columnWidth.Percent {
+			totalPercent += columnWidth.Width
+		}
+		columnWidths[header] = columnWidth
+	}
+	if totalPercent > 100 {
+		return fmt.Errorf("invalid column_widths. The percentages add up to %d%%, more than 100%%", totalPercent)
+	}
+
+	ct.State.columnWidths = columnWidths
+	return nil
+}
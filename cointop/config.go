@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/miguelmota/cointop/pkg/fees"
 	"github.com/miguelmota/cointop/pkg/pathutil"
 	"github.com/miguelmota/cointop/pkg/toml"
 )
@@ -36,13 +37,52 @@ type config struct {
 	Portfolio     map[string]interface{} `toml:"portfolio"`
 	PriceAlerts   map[string]interface{} `toml:"price_alerts"`
 	Currency      interface{}            `toml:"currency"`
+	BaseCurrency  interface{}            `toml:"base_currency"`
 	DefaultView   interface{}            `toml:"default_view"`
 	CoinMarketCap map[string]interface{} `toml:"coinmarketcap"`
+	CoinGecko     map[string]interface{} `toml:"coingecko"`
+	Etherscan     map[string]interface{} `toml:"etherscan"`
 	API           interface{}            `toml:"api"`
+	APIFallbacks  interface{}            `toml:"api_fallbacks"`
 	Colorscheme   interface{}            `toml:"colorscheme"`
+	NoColor       interface{}            `toml:"no_color"`
 	RefreshRate   interface{}            `toml:"refresh_rate"`
+	RefreshResume interface{}            `toml:"refresh_on_resume"`
+	RefreshRates  map[string]interface{} `toml:"refresh_rates"`
+	StartupCheck  interface{}            `toml:"startup_check"`
+	StartTimeout  interface{}            `toml:"startup_timeout"`
 	CacheDir      interface{}            `toml:"cache_dir"`
+	DataDir       interface{}            `toml:"data_dir"`
 	Table         map[string]interface{} `toml:"table"`
+	PortfolioFile interface{}            `toml:"portfolio_file"`
+	AlertsFile    interface{}            `toml:"alerts_file"`
+	TerminalTitle interface{}            `toml:"terminal_title"`
+	Blacklist     interface{}            `toml:"blacklist"`
+	ChartInterval interface{}            `toml:"chart_auto_interval"`
+	ChartTimeAxis interface{}            `toml:"chart_time_axis"`
+	ChartSMA      interface{}            `toml:"chart_sma"`
+	ChartCandles  interface{}            `toml:"chart_candles"`
+	ChartLogScale interface{}            `toml:"chart_log_scale"`
+	Ticker        interface{}            `toml:"ticker"`
+	SMAPeriod     interface{}            `toml:"chart_sma_period"`
+	ChartHeight   interface{}            `toml:"chart_height"`
+	ChartPercent  interface{}            `toml:"chart_height_percent"`
+	ExportDir     interface{}            `toml:"export_dir"`
+	NetworkFees   interface{}            `toml:"network_fees"`
+	SymbolChoice  interface{}            `toml:"symbol_collision"`
+	FuzzySearch   interface{}            `toml:"fuzzy_search"`
+	SearchHistory map[string]interface{} `toml:"search_history"`
+	LivePrices    interface{}            `toml:"live_prices"`
+	HomeAction    interface{}            `toml:"home_action"`
+	ConfirmRemove interface{}            `toml:"confirm_removal"`
+	ThousandsSep  interface{}            `toml:"thousands_separator"`
+	DecimalSep    interface{}            `toml:"decimal_separator"`
+}
+
+// sectionConfig is the config for sections saved in separate files
+type sectionConfig struct {
+	Portfolio   map[string]interface{} `toml:"portfolio"`
+	PriceAlerts map[string]interface{} `toml:"price_alerts"`
 }
 
 // SetupConfig loads config file
@@ -84,9 +124,66 @@ func (ct *Cointop) SetupConfig() error {
 	if err := ct.loadCacheDirFromConfig(); err != nil {
 		return err
 	}
+	if err := ct.loadTerminalTitleFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadBlacklistFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadChartAutoIntervalFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadChartTimeAxisFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadChartSMAFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadChartCandlesFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadChartLogScaleFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadChartHeightFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadTickerFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadExportDirFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadNetworkFeesFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadSymbolCollisionFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadFuzzySearchFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadSearchHistoryFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadLivePricesFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadHomeActionFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadConfirmRemovalFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadNumberFormatFromConfig(); err != nil {
+		return err
+	}
 	if err := ct.loadPriceAlertsFromConfig(); err != nil {
 		return err
 	}
+	if err := ct.loadPortfolioAlertsFromConfig(); err != nil {
+		return err
+	}
 	if err := ct.loadPortfolioFromConfig(); err != nil {
 		return err
 	}
@@ -98,6 +195,14 @@ func (ct *Cointop) SetupConfig() error {
 func (ct *Cointop) CreateConfigIfNotExists() error {
 	ct.debuglog("createConfigIfNotExists()")
 
+	// NOTE: a config filepath set by flag or environment variable is used instead of the legacy locations
+	if ct.ConfigFilePath() != DefaultConfigFilepath {
+		if err := ct.makeConfigDir(); err != nil {
+			return err
+		}
+		return ct.makeConfigFile()
+	}
+
 	for _, configPath := range possibleConfigPaths {
 		normalizedPath := pathutil.NormalizePath(configPath)
 		if _, err := os.Stat(normalizedPath); err == nil {
@@ -169,6 +274,10 @@ func (ct *Cointop) makeConfigFile() error {
 // SaveConfig writes settings to the config file
 func (ct *Cointop) SaveConfig() error {
 	ct.debuglog("saveConfig()")
+	// NOTE: demo mode is read-only so the settings changed while trying it out aren't kept
+	if ct.demoMode {
+		return nil
+	}
 	ct.saveMux.Lock()
 	defer ct.saveMux.Unlock()
 	path := ct.ConfigFilePath()
@@ -181,6 +290,17 @@ func (ct *Cointop) SaveConfig() error {
 		if err != nil {
 			return err
 		}
+
+		if ct.portfolioFile != "" {
+			if err := ct.writeSectionFile(ct.portfolioFile, &sectionConfig{Portfolio: ct.portfolioToToml()}); err != nil {
+				return err
+			}
+		}
+		if ct.alertsFile != "" {
+			if err := ct.writeSectionFile(ct.alertsFile, &sectionConfig{PriceAlerts: ct.priceAlertsToToml()}); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -195,9 +315,140 @@ func (ct *Cointop) parseConfig() error {
 	}
 
 	ct.config = conf
+	if err := ct.loadDataDirFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadSectionFilesFromConfig(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// loadSectionFilesFromConfig loads the portfolio and price alerts sections from separate files if their paths are
+// set. Without a path the section stays in the main config
+func (ct *Cointop) loadSectionFilesFromConfig() error {
+	ct.debuglog("loadSectionFilesFromConfig()")
+	ct.portfolioFile = ""
+	if portfolioFile, ok := ct.config.PortfolioFile.(string); ok {
+		ct.portfolioFile = portfolioFile
+	}
+	ct.alertsFile = ""
+	if alertsFile, ok := ct.config.AlertsFile.(string); ok {
+		ct.alertsFile = alertsFile
+	}
+
+	if ct.portfolioFile != "" {
+		if err := ct.migrateSection(ct.portfolioFile, &sectionConfig{Portfolio: ct.config.Portfolio}); err != nil {
+			return err
+		}
+		section, err := ct.readSectionFile(ct.portfolioFile)
+		if err != nil {
+			return err
+		}
+		if section != nil && section.Portfolio != nil {
+			ct.config.Portfolio = section.Portfolio
+		}
+	}
+	if ct.alertsFile != "" {
+		if err := ct.migrateSection(ct.alertsFile, &sectionConfig{PriceAlerts: ct.config.PriceAlerts}); err != nil {
+			return err
+		}
+		section, err := ct.readSectionFile(ct.alertsFile)
+		if err != nil {
+			return err
+		}
+		if section != nil && section.PriceAlerts != nil {
+			ct.config.PriceAlerts = section.PriceAlerts
+		}
+	}
+
+	return nil
+}
+
+// migrateSection writes the section of the main config to the section file if the file doesn't exist yet, so the
+// section is only moved out of the main config once. The main config drops the section on the next save
+func (ct *Cointop) migrateSection(path string, section *sectionConfig) error {
+	if ct.demoMode || (section.Portfolio == nil && section.PriceAlerts == nil) {
+		return nil
+	}
+	if _, err := os.Stat(ct.sectionFilePath(path)); !os.IsNotExist(err) {
+		return nil
+	}
+
+	ct.debuglog(fmt.Sprintf("migrating section to %s", path))
+	return ct.writeSectionFile(path, section)
+}
+
+// sectionFilePath returns the normalized path of a section file. Relative paths are relative to the data directory
+func (ct *Cointop) sectionFilePath(path string) string {
+	path = pathutil.NormalizePath(path)
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	// NOTE: previous versions resolved relative paths against the config directory
+	return ct.migrateDataFile(filepath.Join(ct.ConfigDirPath(), path), filepath.Join(ct.State.dataDir, path))
+}
+
+// migrateDataFile moves a file from its legacy location to the data directory if it's only found in the legacy
+// location. It returns the path to use, which stays the legacy path if the file can't be moved
+func (ct *Cointop) migrateDataFile(legacyPath string, path string) string {
+	if legacyPath == path {
+		return path
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return path
+	}
+	if _, err := os.Stat(legacyPath); err != nil {
+		return path
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		ct.debuglog(fmt.Sprintf("failed to migrate %s: %s", legacyPath, err))
+		return legacyPath
+	}
+	if err := os.Rename(legacyPath, path); err != nil {
+		ct.debuglog(fmt.Sprintf("failed to migrate %s: %s", legacyPath, err))
+		return legacyPath
+	}
+
+	ct.debuglog(fmt.Sprintf("migrated %s to %s", legacyPath, path))
+	return path
+}
+
+// readSectionFile decodes a section file. It returns nil if the file doesn't exist yet
+func (ct *Cointop) readSectionFile(path string) (*sectionConfig, error) {
+	path = ct.sectionFilePath(path)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		// NOTE: the section in the main config is used until the file is saved
+		return nil, nil
+	}
+
+	var section sectionConfig
+	if _, err := toml.DecodeFile(path, &section); err != nil {
+		return nil, err
+	}
+
+	return &section, nil
+}
+
+// writeSectionFile encodes and writes a section file
+func (ct *Cointop) writeSectionFile(path string, section *sectionConfig) error {
+	var b bytes.Buffer
+	encoder := toml.NewEncoder(&b)
+	if err := encoder.Encode(section); err != nil {
+		return err
+	}
+
+	path = ct.sectionFilePath(path)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, b.Bytes(), fileperm)
+}
+
 // ConfigToToml encodes config struct to TOML
 func (ct *Cointop) configToToml() ([]byte, error) {
 	ct.debuglog("configToToml()")
@@ -227,74 +478,177 @@ func (ct *Cointop) configToToml() ([]byte, error) {
 
 	var favoritesColumnsIfc interface{} = ct.State.favoritesTableColumns
 	favoritesMapIfc["columns"] = favoritesColumnsIfc
-
-	portfolioIfc := map[string]interface{}{}
-	var holdingsIfc [][]string
-	for name := range ct.State.portfolio.Entries {
-		entry, ok := ct.State.portfolio.Entries[name]
-		if !ok || entry.Coin == "" {
-			continue
-		}
-		var amount string = strconv.FormatFloat(entry.Holdings, 'f', -1, 64)
-		var coinName string = entry.Coin
-		var tuple []string = []string{coinName, amount}
-		holdingsIfc = append(holdingsIfc, tuple)
-	}
-	sort.Slice(holdingsIfc, func(i, j int) bool {
-		return holdingsIfc[i][0] < holdingsIfc[j][0]
-	})
-	portfolioIfc["holdings"] = holdingsIfc
-
-	var columnsIfc interface{} = ct.State.portfolioTableColumns
-	portfolioIfc["columns"] = columnsIfc
-
-	var currencyIfc interface{} = ct.State.currencyConversion
+	var favoriteOnSearchIfc interface{} = ct.State.favoriteOnSearch
+	favoritesMapIfc["favorite_on_search"] = favoriteOnSearchIfc
+	var favoritesHighlightIfc interface{} = ct.State.favoritesHighlight
+	favoritesMapIfc["highlight"] = favoritesHighlightIfc
+	var favoritesSummaryIfc interface{} = ct.State.favoritesSummary
+	favoritesMapIfc["statusbar_summary"] = favoritesSummaryIfc
+	var favoriteGroupsIfc interface{} = ct.favoriteGroupsToToml()
+	favoritesMapIfc["groups"] = favoriteGroupsIfc
+
+	portfolioIfc := ct.portfolioToToml()
+
+	var currencyIfc interface{} = ct.SavedCurrencyConversion()
+	var baseCurrencyIfc interface{} = ct.State.baseCurrency
 	var defaultViewIfc interface{} = ct.State.defaultView
 	var colorschemeIfc interface{} = ct.colorschemeName
+	var noColorIfc interface{} = ct.noColor
 	var refreshRateIfc interface{} = uint(ct.State.refreshRate.Seconds())
+	var refreshOnResumeIfc interface{} = ct.State.refreshOnResume
+	var startupCheckIfc interface{} = ct.State.startupCheck
+	var startupTimeoutIfc interface{} = uint(ct.State.startupTimeout.Seconds())
+	// NOTE: directories set by environment variables are left as they are in the config
 	var cacheDirIfc interface{} = ct.State.cacheDir
+	if ct.cacheDirEnv != "" {
+		cacheDirIfc = ct.config.CacheDir
+	}
+	var dataDirIfc interface{} = ct.State.dataDir
+	if ct.dataDirEnv != "" {
+		dataDirIfc = ct.config.DataDir
+	}
+	var terminalTitleIfc interface{} = ct.State.terminalTitle
 
 	cmcIfc := map[string]interface{}{
 		"pro_api_key": ct.apiKeys.cmc,
+		"base_url":    ct.cmcBaseURL,
 	}
 
-	var apiChoiceIfc interface{} = ct.apiChoice
+	etherscanIfc := map[string]interface{}{
+		"api_key": ct.apiKeys.etherscan,
+	}
 
-	var priceAlertsIfc []interface{}
-	for _, priceAlert := range ct.State.priceAlerts.Entries {
-		if priceAlert.Expired {
-			continue
-		}
-		priceAlertsIfc = append(priceAlertsIfc, []string{
-			priceAlert.CoinName,
-			priceAlert.Operator,
-			strconv.FormatFloat(priceAlert.TargetPrice, 'f', -1, 64),
-			priceAlert.Frequency,
-		})
+	cgIfc := map[string]interface{}{
+		"max_pages":         ct.cgMaxPages,
+		"full_refresh_ids":  ct.cgRefreshIDs,
+		"batch_concurrency": ct.cgConcurrency,
 	}
-	priceAlertsMapIfc := map[string]interface{}{
-		"alerts": priceAlertsIfc,
-		//"sound":  ct.State.priceAlerts.SoundEnabled,
+	if len(ct.cgIDOverrides) > 0 {
+		cgIfc["id_overrides"] = ct.cgIDOverrides
 	}
 
+	var apiChoiceIfc interface{} = ct.apiChoice
+	var apiFallbacksIfc interface{} = ct.apiFallbacks
+	var blacklistIfc interface{} = ct.State.blacklist
+	var chartAutoIntervalIfc interface{} = ct.State.chartAutoInterval
+	var chartTimeAxisIfc interface{} = ct.State.chartTimeAxis
+	var chartSMAIfc interface{} = ct.State.chartSMA
+	var chartCandlesIfc interface{} = ct.State.chartCandles
+	var chartLogScaleIfc interface{} = ct.State.chartLogScale
+	var tickerIfc interface{} = ct.State.tickerVisible
+	var chartSMAPeriodIfc interface{} = ct.State.chartSMAPeriod
+	var chartHeightPercentIfc interface{} = ct.State.chartHeightPercent
+	var exportDirIfc interface{} = ct.State.exportDir
+	var networkFeesIfc interface{} = ct.State.networkFees
+	var symbolCollisionIfc interface{} = ct.State.symbolCollision
+	var fuzzySearchIfc interface{} = ct.State.fuzzySearch
+	var livePricesIfc interface{} = ct.State.livePrices
+	var homeActionIfc interface{} = ct.State.homeActions
+	var confirmRemovalIfc interface{} = ct.State.confirmRemoval
+	var thousandsSeparatorIfc interface{} = ct.State.thousandsSeparator
+	var decimalSeparatorIfc interface{} = ct.State.decimalSeparator
+
+	priceAlertsMapIfc := ct.priceAlertsToToml()
+
 	var coinsTableColumnsIfc interface{} = ct.State.coinsTableColumns
 	tableMapIfc := map[string]interface{}{}
 	tableMapIfc["columns"] = coinsTableColumnsIfc
 	var keepRowFocusOnSortIfc interface{} = ct.State.keepRowFocusOnSort
 	tableMapIfc["keep_row_focus_on_sort"] = keepRowFocusOnSortIfc
+	var priceTickColorIfc interface{} = ct.State.priceTickColor
+	tableMapIfc["price_tick_color"] = priceTickColorIfc
+	var perPageIfc interface{} = ct.State.perPage
+	tableMapIfc["per_page"] = perPageIfc
+	var minWidthIfc interface{} = ct.State.minLayoutWidth
+	tableMapIfc["min_width"] = minWidthIfc
+	var minRowsIfc interface{} = ct.State.minTableRows
+	tableMapIfc["min_rows"] = minRowsIfc
+	var topMoversScopeIfc interface{} = ct.State.topMoversScope
+	tableMapIfc["top_movers_scope"] = topMoversScopeIfc
+	var moversCountIfc interface{} = ct.State.moversCount
+	tableMapIfc["movers_count"] = moversCountIfc
+	var benchmarkCoinIfc interface{} = ct.State.benchmarkCoin
+	tableMapIfc["relative_strength_benchmark"] = benchmarkCoinIfc
+	var scrollOffIfc interface{} = ct.State.scrollOff
+	tableMapIfc["scroll_off"] = scrollOffIfc
+	var changeWindowIfc interface{} = ct.State.changeWindow
+	tableMapIfc["change_window"] = changeWindowIfc
+	var rankChangeModeIfc interface{} = ct.State.rankChangeMode
+	tableMapIfc["rank_change"] = rankChangeModeIfc
+	var volumeInCoinIfc interface{} = ct.State.volumeInCoin
+	tableMapIfc["volume_in_coin"] = volumeInCoinIfc
+	tableMapIfc["view_sort"] = ct.viewSortsToToml()
+	tableMapIfc["column_widths"] = ct.columnWidthsToToml()
+	var pricePrecisionIfc interface{} = ct.State.pricePrecision
+	if ct.State.pricePrecision == PricePrecisionAuto {
+		pricePrecisionIfc = "auto"
+	}
+	tableMapIfc["price_precision"] = pricePrecisionIfc
+	tableMapIfc["price_rounding"] = ct.priceRoundingRulesToToml()
+	var priceSignificantDigitsIfc interface{} = ct.State.priceSigDigits
+	tableMapIfc["price_significant_digits"] = priceSignificantDigitsIfc
+	var priceCurrenciesIfc interface{} = ct.State.priceCurrencies
+	tableMapIfc["price_currencies"] = priceCurrenciesIfc
+	var savedPageIfc interface{} = ct.State.savedPage
+	tableMapIfc["last_page"] = savedPageIfc
+	var savedRowIndexIfc interface{} = ct.State.savedRowIndex
+	tableMapIfc["last_row_index"] = savedRowIndexIfc
+
+	// NOTE: sections saved in separate files are left out of the main config
+	var portfolioFileIfc interface{} = ct.portfolioFile
+	if ct.portfolioFile != "" {
+		portfolioIfc = nil
+	}
+	var alertsFileIfc interface{} = ct.alertsFile
+	if ct.alertsFile != "" {
+		priceAlertsMapIfc = nil
+	}
 
 	var inputs = &config{
 		API:           apiChoiceIfc,
+		APIFallbacks:  apiFallbacksIfc,
 		Colorscheme:   colorschemeIfc,
+		NoColor:       noColorIfc,
 		CoinMarketCap: cmcIfc,
+		CoinGecko:     cgIfc,
+		Etherscan:     etherscanIfc,
 		Currency:      currencyIfc,
+		BaseCurrency:  baseCurrencyIfc,
 		DefaultView:   defaultViewIfc,
 		Favorites:     favoritesMapIfc,
 		RefreshRate:   refreshRateIfc,
+		RefreshResume: refreshOnResumeIfc,
+		RefreshRates:  ct.refreshRatesToToml(),
+		StartupCheck:  startupCheckIfc,
+		StartTimeout:  startupTimeoutIfc,
 		Shortcuts:     shortcutsIfcs,
+		PortfolioFile: portfolioFileIfc,
+		AlertsFile:    alertsFileIfc,
+		TerminalTitle: terminalTitleIfc,
+		Blacklist:     blacklistIfc,
+		ChartInterval: chartAutoIntervalIfc,
+		ChartTimeAxis: chartTimeAxisIfc,
+		ChartSMA:      chartSMAIfc,
+		ChartCandles:  chartCandlesIfc,
+		ChartLogScale: chartLogScaleIfc,
+		Ticker:        tickerIfc,
+		SMAPeriod:     chartSMAPeriodIfc,
+		ChartHeight:   ct.chartHeightToToml(),
+		ChartPercent:  chartHeightPercentIfc,
+		ExportDir:     exportDirIfc,
+		NetworkFees:   networkFeesIfc,
+		SymbolChoice:  symbolCollisionIfc,
+		FuzzySearch:   fuzzySearchIfc,
+		SearchHistory: ct.searchHistoryToToml(),
+		LivePrices:    livePricesIfc,
+		HomeAction:    homeActionIfc,
+		ConfirmRemove: confirmRemovalIfc,
+		ThousandsSep:  thousandsSeparatorIfc,
+		DecimalSep:    decimalSeparatorIfc,
 		Portfolio:     portfolioIfc,
 		PriceAlerts:   priceAlertsMapIfc,
 		CacheDir:      cacheDirIfc,
+		DataDir:       dataDirIfc,
 		Table:         tableMapIfc,
 	}
 
@@ -308,6 +662,77 @@ func (ct *Cointop) configToToml() ([]byte, error) {
 	return b.Bytes(), nil
 }
 
+// portfolioToToml returns the portfolio config section
+func (ct *Cointop) portfolioToToml() map[string]interface{} {
+	portfolioIfc := map[string]interface{}{}
+	var holdingsIfc [][]string
+	for name := range ct.State.portfolio.Entries {
+		entry, ok := ct.State.portfolio.Entries[name]
+		if !ok || entry.Coin == "" {
+			continue
+		}
+		var amount string = strconv.FormatFloat(entry.Holdings, 'f', -1, 64)
+		var coinName string = entry.Coin
+		var tuple []string = []string{coinName, amount}
+		holdingsIfc = append(holdingsIfc, tuple)
+	}
+	sort.Slice(holdingsIfc, func(i, j int) bool {
+		return holdingsIfc[i][0] < holdingsIfc[j][0]
+	})
+	portfolioIfc["holdings"] = holdingsIfc
+	portfolioIfc["lots"] = ct.portfolioLotsToToml()
+
+	var columnsIfc interface{} = ct.State.portfolioTableColumns
+	portfolioIfc["columns"] = columnsIfc
+
+	var holdingsPrecisionIfc interface{} = ct.State.holdingsPrecision
+	if ct.State.holdingsPrecision == HoldingsPrecisionAuto {
+		holdingsPrecisionIfc = "auto"
+	}
+	portfolioIfc["holdings_precision"] = holdingsPrecisionIfc
+
+	var valueCurrenciesIfc interface{} = ct.State.valueCurrencies
+	portfolioIfc["value_currencies"] = valueCurrenciesIfc
+
+	var privacyModeIfc interface{} = ct.State.privacyMode
+	portfolioIfc["privacy_mode"] = privacyModeIfc
+
+	var currencyIfc interface{} = ct.State.portfolioCurrency
+	portfolioIfc["currency"] = currencyIfc
+
+	return portfolioIfc
+}
+
+// priceAlertsToToml returns the price alerts config section
+func (ct *Cointop) priceAlertsToToml() map[string]interface{} {
+	var priceAlertsIfc []interface{}
+	for _, priceAlert := range ct.State.priceAlerts.Entries {
+		if priceAlert.Expired {
+			continue
+		}
+		tuple := []string{
+			priceAlert.CoinName,
+			priceAlert.Operator,
+			strconv.FormatFloat(priceAlert.TargetPrice, 'f', -1, 64),
+			priceAlert.Frequency,
+		}
+		// NOTE: the last triggered time is kept so a reoccurring alert doesn't notify again on restart within the cooldown
+		if !priceAlert.LastTriggered.IsZero() {
+			tuple = append(tuple, priceAlert.LastTriggered.UTC().Format(time.RFC3339))
+		}
+		priceAlertsIfc = append(priceAlertsIfc, tuple)
+	}
+	priceAlertsMapIfc := map[string]interface{}{
+		"alerts":           priceAlertsIfc,
+		"portfolio_alerts": ct.portfolioAlertsToToml(),
+		"sound":            ct.State.priceAlerts.SoundEnabled,
+		"notify_desktop":   ct.State.priceAlerts.NotifyDesktop,
+		"webhook":          ct.State.priceAlerts.Webhook,
+	}
+
+	return priceAlertsMapIfc
+}
+
 // LoadTableConfig loads table config from toml config into state struct
 func (ct *Cointop) loadTableConfig() error {
 	err := ct.loadTableColumnsFromConfig()
@@ -319,6 +744,76 @@ func (ct *Cointop) loadTableConfig() error {
 	if ok {
 		ct.State.keepRowFocusOnSort = keepRowFocusOnSortIfc.(bool)
 	}
+
+	if priceTickColor, ok := ct.config.Table["price_tick_color"].(bool); ok {
+		ct.State.priceTickColor = priceTickColor
+	}
+	if perPage, ok := ct.config.Table["per_page"].(int64); ok {
+		if err := validatePerPage(int(perPage)); err != nil {
+			return fmt.Errorf("invalid per_page %d. %v", perPage, err)
+		}
+		ct.State.perPage = int(perPage)
+	}
+	if minWidth, ok := ct.config.Table["min_width"].(int64); ok && minWidth >= 0 {
+		ct.State.minLayoutWidth = int(minWidth)
+	}
+	if minRows, ok := ct.config.Table["min_rows"].(int64); ok && minRows >= 0 {
+		ct.State.minTableRows = int(minRows)
+	}
+	if scope, ok := ct.config.Table["top_movers_scope"].(string); ok {
+		if scope != TopMoversScopeAll && scope != TopMoversScopePage {
+			return fmt.Errorf("invalid top_movers_scope %q. Valid values are %q and %q", scope, TopMoversScopeAll, TopMoversScopePage)
+		}
+		ct.State.topMoversScope = scope
+	}
+	if moversCount, ok := ct.config.Table["movers_count"].(int64); ok {
+		if moversCount < 1 {
+			return fmt.Errorf("invalid movers_count %d. It must be at least 1", moversCount)
+		}
+		ct.State.moversCount = int(moversCount)
+	}
+	if benchmark, ok := ct.config.Table["relative_strength_benchmark"].(string); ok && benchmark != "" {
+		ct.State.benchmarkCoin = benchmark
+	}
+	if scrollOff, ok := ct.config.Table["scroll_off"].(int64); ok && scrollOff >= 0 {
+		ct.State.scrollOff = int(scrollOff)
+	}
+	if changeWindow, ok := ct.config.Table["change_window"].(string); ok {
+		valid := false
+		for _, window := range ChangeWindows {
+			if window == changeWindow {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid change_window %q. Valid values are %q", changeWindow, ChangeWindows)
+		}
+		ct.State.changeWindow = changeWindow
+	}
+	if rankChangeMode, ok := ct.config.Table["rank_change"].(string); ok {
+		if rankChangeMode != RankChangeDay && rankChangeMode != RankChangeRefresh {
+			return fmt.Errorf("invalid rank_change %q. Valid values are %q and %q", rankChangeMode, RankChangeDay, RankChangeRefresh)
+		}
+		ct.State.rankChangeMode = rankChangeMode
+	}
+	if volumeInCoin, ok := ct.config.Table["volume_in_coin"].(bool); ok {
+		ct.State.volumeInCoin = volumeInCoin
+	}
+	if err := ct.loadViewSortsFromConfig(ct.config.Table["view_sort"]); err != nil {
+		return err
+	}
+	if err := ct.loadColumnWidthsFromConfig(ct.config.Table["column_widths"]); err != nil {
+		return err
+	}
+	if err := ct.loadPriceRoundingFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadPriceCurrenciesFromConfig(); err != nil {
+		return err
+	}
+	if err := ct.loadTablePositionFromConfig(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -362,12 +857,33 @@ func (ct *Cointop) loadShortcutsFromConfig() error {
 	return nil
 }
 
+// ConfigCurrency returns the currency of the config file, or USD if it isn't set. An empty path uses the
+// COINTOP_CONFIG environment variable or the default config filepath
+func ConfigCurrency(path string) (string, error) {
+	path = pathutil.NormalizePath(resolveConfigFilepath(path))
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return "USD", nil
+	}
+
+	var conf config
+	if _, err := toml.DecodeFile(path, &conf); err != nil {
+		return "", err
+	}
+	if currency, ok := conf.Currency.(string); ok && strings.TrimSpace(currency) != "" {
+		return strings.ToUpper(strings.TrimSpace(currency)), nil
+	}
+	return "USD", nil
+}
+
 // LoadCurrencyFromConfig loads currency from config file to struct
 func (ct *Cointop) loadCurrencyFromConfig() error {
 	ct.debuglog("loadCurrencyFromConfig()")
 	if currency, ok := ct.config.Currency.(string); ok {
 		ct.State.currencyConversion = strings.ToUpper(currency)
 	}
+	if baseCurrency, ok := ct.config.BaseCurrency.(string); ok && baseCurrency != "" {
+		ct.State.baseCurrency = strings.ToUpper(strings.TrimSpace(baseCurrency))
+	}
 	return nil
 }
 
@@ -377,19 +893,12 @@ func (ct *Cointop) loadDefaultViewFromConfig() error {
 	if defaultView, ok := ct.config.DefaultView.(string); ok {
 		defaultView = strings.ToLower(defaultView)
 		switch defaultView {
-		case "portfolio":
-			ct.SetSelectedView(PortfolioView)
-		case "favorites":
-			ct.SetSelectedView(FavoritesView)
-		case "alerts", "price_alerts":
-			ct.SetSelectedView(PriceAlertsView)
-		case "default":
-			fallthrough
+		case "portfolio", "favorites", "alerts", "price_alerts":
 		default:
-			ct.SetSelectedView(CoinsView)
 			defaultView = "default"
 		}
 		ct.State.defaultView = defaultView
+		ct.SetSelectedView(ct.DefaultViewName())
 	}
 	return nil
 }
@@ -402,6 +911,45 @@ func (ct *Cointop) loadAPIKeysFromConfig() error {
 		if k == "pro_api_key" {
 			ct.apiKeys.cmc = value.(string)
 		}
+		if k == "base_url" {
+			if baseURL, ok := value.(string); ok {
+				ct.cmcBaseURL = strings.TrimSpace(baseURL)
+			}
+		}
+	}
+	for key, value := range ct.config.CoinGecko {
+		k := strings.TrimSpace(strings.ToLower(key))
+		if k == "max_pages" {
+			if maxPages, ok := value.(int64); ok {
+				ct.cgMaxPages = int(maxPages)
+			}
+		}
+		if k == "batch_concurrency" {
+			batchConcurrency, ok := value.(int64)
+			if !ok || batchConcurrency < 1 || int(batchConcurrency) > MaxCoinGeckoBatchConcurrency {
+				return fmt.Errorf("invalid coingecko batch_concurrency. It must be between 1 and %d", MaxCoinGeckoBatchConcurrency)
+			}
+			ct.cgConcurrency = int(batchConcurrency)
+		}
+		if k == "full_refresh_ids" {
+			if refreshIDs, ok := value.(bool); ok {
+				ct.cgRefreshIDs = refreshIDs
+			}
+		}
+		if k == "id_overrides" {
+			overrides, ok := value.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid coingecko id_overrides. Expected a table of names or symbols to coin IDs")
+			}
+			ct.cgIDOverrides = make(map[string]string)
+			for name, idIfc := range overrides {
+				id, ok := idIfc.(string)
+				if !ok || strings.TrimSpace(id) == "" {
+					return fmt.Errorf("invalid coingecko id override for %q. Expected a coin ID", name)
+				}
+				ct.cgIDOverrides[name] = id
+			}
+		}
 	}
 	return nil
 }
@@ -412,6 +960,136 @@ func (ct *Cointop) loadColorschemeFromConfig() error {
 	if colorscheme, ok := ct.config.Colorscheme.(string); ok {
 		ct.colorschemeName = colorscheme
 	}
+	if noColor, ok := ct.config.NoColor.(bool); ok {
+		ct.noColor = noColor
+	}
+
+	return nil
+}
+
+// loadTerminalTitleFromConfig loads the terminal title setting from config file to struct
+func (ct *Cointop) loadTerminalTitleFromConfig() error {
+	ct.debuglog("loadTerminalTitleFromConfig()")
+	if terminalTitle, ok := ct.config.TerminalTitle.(bool); ok {
+		ct.State.terminalTitle = terminalTitle
+	}
+
+	return nil
+}
+
+// loadBlacklistFromConfig loads the blacklisted coin names and IDs from config file to struct
+func (ct *Cointop) loadBlacklistFromConfig() error {
+	ct.debuglog("loadBlacklistFromConfig()")
+	if ifcs, ok := ct.config.Blacklist.([]interface{}); ok {
+		var blacklist []string
+		for _, ifc := range ifcs {
+			if v, ok := ifc.(string); ok && strings.TrimSpace(v) != "" {
+				blacklist = append(blacklist, strings.TrimSpace(v))
+			}
+		}
+		ct.State.blacklist = blacklist
+	}
+
+	return nil
+}
+
+// loadChartAutoIntervalFromConfig loads the chart auto interval setting from config file to struct
+func (ct *Cointop) loadChartAutoIntervalFromConfig() error {
+	ct.debuglog("loadChartAutoIntervalFromConfig()")
+	if chartAutoInterval, ok := ct.config.ChartInterval.(bool); ok {
+		ct.State.chartAutoInterval = chartAutoInterval
+	}
+
+	return nil
+}
+
+// loadChartTimeAxisFromConfig loads the chart time axis setting from config file to struct
+func (ct *Cointop) loadChartTimeAxisFromConfig() error {
+	ct.debuglog("loadChartTimeAxisFromConfig()")
+	if chartTimeAxis, ok := ct.config.ChartTimeAxis.(bool); ok {
+		ct.State.chartTimeAxis = chartTimeAxis
+	}
+
+	return nil
+}
+
+// loadExportDirFromConfig loads the export dir from config file to struct
+func (ct *Cointop) loadExportDirFromConfig() error {
+	ct.debuglog("loadExportDirFromConfig()")
+	if exportDir, ok := ct.config.ExportDir.(string); ok && exportDir != "" {
+		ct.State.exportDir = exportDir
+	}
+
+	return nil
+}
+
+// loadNetworkFeesFromConfig loads the network fees setting from config file to struct
+func (ct *Cointop) loadNetworkFeesFromConfig() error {
+	ct.debuglog("loadNetworkFeesFromConfig()")
+	if networkFees, ok := ct.config.NetworkFees.(bool); ok {
+		ct.State.networkFees = networkFees
+	}
+	if apiKey, ok := ct.config.Etherscan["api_key"].(string); ok {
+		ct.apiKeys.etherscan = strings.TrimSpace(apiKey)
+	}
+	apiKey := ct.apiKeys.etherscan
+	if apiKey == "" {
+		apiKey = os.Getenv("ETHERSCAN_API_KEY")
+	}
+	fees.Register("ETH", &fees.EtherscanFetcher{
+		BaseURL: fees.EtherscanBaseURL,
+		APIKey:  apiKey,
+	})
+
+	return nil
+}
+
+// loadSymbolCollisionFromConfig loads how a searched symbol shared by several coins is resolved from config file to struct
+func (ct *Cointop) loadSymbolCollisionFromConfig() error {
+	ct.debuglog("loadSymbolCollisionFromConfig()")
+	if symbolCollision, ok := ct.config.SymbolChoice.(string); ok {
+		if symbolCollision != SymbolCollisionAsk && symbolCollision != SymbolCollisionRank {
+			return fmt.Errorf("invalid symbol_collision %q. Valid values are %q and %q", symbolCollision, SymbolCollisionAsk, SymbolCollisionRank)
+		}
+		ct.State.symbolCollision = symbolCollision
+	}
+
+	return nil
+}
+
+// loadHomeActionFromConfig loads the steps of the home action from config file to struct
+func (ct *Cointop) loadHomeActionFromConfig() error {
+	ct.debuglog("loadHomeActionFromConfig()")
+	if ifcs, ok := ct.config.HomeAction.([]interface{}); ok {
+		homeActions := []string{}
+		for _, ifc := range ifcs {
+			step, ok := ifc.(string)
+			if !ok {
+				continue
+			}
+			valid := false
+			for _, v := range HomeActions {
+				if v == step {
+					valid = true
+				}
+			}
+			if !valid {
+				return fmt.Errorf("invalid home_action %q. Valid values are %q", step, HomeActions)
+			}
+			homeActions = append(homeActions, step)
+		}
+		ct.State.homeActions = homeActions
+	}
+
+	return nil
+}
+
+// loadConfirmRemovalFromConfig loads the setting to confirm removing favorites and portfolio entries from config file to struct
+func (ct *Cointop) loadConfirmRemovalFromConfig() error {
+	ct.debuglog("loadConfirmRemovalFromConfig()")
+	if confirmRemoval, ok := ct.config.ConfirmRemove.(bool); ok {
+		ct.State.confirmRemoval = confirmRemoval
+	}
 
 	return nil
 }
@@ -422,6 +1100,18 @@ func (ct *Cointop) loadRefreshRateFromConfig() error {
 	if refreshRate, ok := ct.config.RefreshRate.(int64); ok {
 		ct.State.refreshRate = time.Duration(uint(refreshRate)) * time.Second
 	}
+	if err := ct.loadRefreshRatesFromConfig(); err != nil {
+		return err
+	}
+	if refreshOnResume, ok := ct.config.RefreshResume.(bool); ok {
+		ct.State.refreshOnResume = refreshOnResume
+	}
+	if startupCheck, ok := ct.config.StartupCheck.(bool); ok {
+		ct.State.startupCheck = startupCheck
+	}
+	if startupTimeout, ok := ct.config.StartTimeout.(int64); ok && startupTimeout > 0 {
+		ct.State.startupTimeout = time.Duration(startupTimeout) * time.Second
+	}
 
 	return nil
 }
@@ -432,6 +1122,25 @@ func (ct *Cointop) loadCacheDirFromConfig() error {
 	if cacheDir, ok := ct.config.CacheDir.(string); ok {
 		ct.State.cacheDir = pathutil.NormalizePath(cacheDir)
 	}
+	if ct.cacheDirEnv != "" {
+		ct.State.cacheDir = pathutil.NormalizePath(ct.cacheDirEnv)
+	}
+
+	return nil
+}
+
+// loadDataDirFromConfig loads the data dir from the config file, environment and flag to struct
+func (ct *Cointop) loadDataDirFromConfig() error {
+	ct.debuglog("loadDataDirFromConfig()")
+	if dataDir, ok := ct.config.DataDir.(string); ok && dataDir != "" {
+		ct.State.dataDir = pathutil.NormalizePath(dataDir)
+	}
+	if ct.dataDirEnv != "" {
+		ct.State.dataDir = pathutil.NormalizePath(ct.dataDirEnv)
+	}
+	if ct.dataDirFlag != "" {
+		ct.State.dataDir = pathutil.NormalizePath(ct.dataDirFlag)
+	}
 
 	return nil
 }
@@ -481,12 +1190,42 @@ func (ct *Cointop) loadAPIChoiceFromConfig() error {
 		apiChoice = strings.TrimSpace(strings.ToLower(apiChoice))
 		ct.apiChoice = apiChoice
 	}
+	if ifcs, ok := ct.config.APIFallbacks.([]interface{}); ok {
+		var apiFallbacks []string
+		for _, ifc := range ifcs {
+			v, ok := ifc.(string)
+			if !ok {
+				continue
+			}
+			v = strings.TrimSpace(strings.ToLower(v))
+			if v != CoinMarketCap && v != CoinGecko && v != CoinPaprika {
+				return ErrInvalidAPIChoice
+			}
+			apiFallbacks = append(apiFallbacks, v)
+		}
+		ct.apiFallbacks = apiFallbacks
+	}
 	return nil
 }
 
 // LoadFavoritesFromConfig loads favorites data from config file to struct
 func (ct *Cointop) loadFavoritesFromConfig() error {
 	ct.debuglog("loadFavoritesFromConfig()")
+	if favoriteOnSearch, ok := ct.config.Favorites["favorite_on_search"].(bool); ok {
+		ct.State.favoriteOnSearch = favoriteOnSearch
+	}
+	if highlight, ok := ct.config.Favorites["highlight"].(string); ok {
+		if highlight != FavoritesHighlightColor && highlight != FavoritesHighlightStar && highlight != FavoritesHighlightNone {
+			return fmt.Errorf("invalid favorites highlight %q. Valid values are %q, %q and %q", highlight, FavoritesHighlightColor, FavoritesHighlightStar, FavoritesHighlightNone)
+		}
+		ct.State.favoritesHighlight = highlight
+	}
+	if favoritesSummary, ok := ct.config.Favorites["statusbar_summary"].(bool); ok {
+		ct.State.favoritesSummary = favoritesSummary
+	}
+	if err := ct.loadFavoriteGroupsFromConfig(ct.config.Favorites["groups"]); err != nil {
+		return err
+	}
 	for k, valueIfc := range ct.config.Favorites {
 		ifcs, ok := valueIfc.([]interface{})
 		if !ok {
@@ -530,6 +1269,7 @@ func (ct *Cointop) loadFavoritesFromConfig() error {
 func (ct *Cointop) loadPortfolioFromConfig() error {
 	ct.debuglog("loadPortfolioFromConfig()")
 
+	var lotsIfc interface{}
 	for key, valueIfc := range ct.config.Portfolio {
 		if key == "columns" {
 			var columns []string
@@ -547,6 +1287,37 @@ func (ct *Cointop) loadPortfolioFromConfig() error {
 					ct.State.portfolioTableColumns = columns
 				}
 			}
+		} else if key == "lots" {
+			lotsIfc = valueIfc
+		} else if key == "holdings_precision" {
+			if v, ok := valueIfc.(string); ok && v == "auto" {
+				ct.State.holdingsPrecision = HoldingsPrecisionAuto
+			} else if v, ok := valueIfc.(int64); ok && v >= int64(HoldingsPrecisionFull) {
+				ct.State.holdingsPrecision = int(v)
+			} else {
+				return fmt.Errorf("invalid holdings_precision %v. Valid values are \"auto\", -1 for full precision, or the number of decimal places", valueIfc)
+			}
+		} else if key == "value_currencies" {
+			var currencies []string
+			ifcs, ok := valueIfc.([]interface{})
+			if ok {
+				for _, ifc := range ifcs {
+					if v, ok := ifc.(string); ok {
+						currencies = append(currencies, strings.ToUpper(v))
+					}
+				}
+				ct.State.valueCurrencies = currencies
+			}
+		} else if key == "privacy_mode" {
+			if v, ok := valueIfc.(bool); ok {
+				ct.State.privacyMode = v
+			}
+		} else if key == "currency" {
+			v, ok := valueIfc.(string)
+			if !ok {
+				return fmt.Errorf("invalid portfolio currency %v", valueIfc)
+			}
+			ct.State.portfolioCurrency = strings.ToUpper(strings.TrimSpace(v))
 		} else if key == "holdings" {
 			holdingsIfc, ok := valueIfc.([]interface{})
 			if !ok {
@@ -571,9 +1342,7 @@ func (ct *Cointop) loadPortfolioFromConfig() error {
 					return nil
 				}
 
-				if err := ct.SetPortfolioEntry(name, holdings); err != nil {
-					return err
-				}
+				ct.setPortfolioEntry(name, holdings)
 			}
 		} else {
 			// Backward compatibility < v1.6.0
@@ -582,12 +1351,15 @@ func (ct *Cointop) loadPortfolioFromConfig() error {
 				return err
 			}
 
-			if err := ct.SetPortfolioEntry(key, holdings); err != nil {
-				return err
-			}
+			ct.setPortfolioEntry(key, holdings)
 		}
 	}
 
+	// lots are loaded last since setting the holdings discards the lots
+	if err := ct.loadPortfolioLotsFromConfig(lotsIfc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -604,7 +1376,7 @@ func (ct *Cointop) loadPriceAlertsFromConfig() error {
 	}
 	for _, priceAlertIfc := range priceAlertsSliceIfc {
 		priceAlert, ok := priceAlertIfc.([]interface{})
-		if !ok {
+		if !ok || len(priceAlert) < 4 {
 			return ErrInvalidPriceAlert
 		}
 		coinName, ok := priceAlert[0].(string)
@@ -637,6 +1409,17 @@ func (ct *Cointop) loadPriceAlertsFromConfig() error {
 			TargetPrice: targetPrice,
 			Frequency:   frequency,
 		}
+		if len(priceAlert) > 4 {
+			lastTriggeredStr, ok := priceAlert[4].(string)
+			if !ok {
+				return ErrInvalidPriceAlert
+			}
+			lastTriggered, err := time.Parse(time.RFC3339, lastTriggeredStr)
+			if err != nil {
+				return ErrInvalidPriceAlert
+			}
+			entry.LastTriggered = lastTriggered
+		}
 		ct.State.priceAlerts.Entries = append(ct.State.priceAlerts.Entries, entry)
 	}
 	soundIfc, ok := ct.config.PriceAlerts["sound"]
@@ -647,6 +1430,20 @@ func (ct *Cointop) loadPriceAlertsFromConfig() error {
 		}
 		ct.State.priceAlerts.SoundEnabled = enabled
 	}
+	if notifyDesktop, ok := ct.config.PriceAlerts["notify_desktop"]; ok {
+		enabled, ok := notifyDesktop.(bool)
+		if !ok {
+			return ErrInvalidPriceAlert
+		}
+		ct.State.priceAlerts.NotifyDesktop = enabled
+	}
+	if webhookIfc, ok := ct.config.PriceAlerts["webhook"]; ok {
+		webhook, ok := webhookIfc.(string)
+		if !ok {
+			return ErrInvalidPriceAlert
+		}
+		ct.State.priceAlerts.Webhook = strings.TrimSpace(webhook)
+	}
 
 	return nil
 }
@@ -0,0 +1,176 @@
+package cointop
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestMigrateSections checks that the inline portfolio and price alerts sections are moved to the files set in the
+// config once
+func TestMigrateSections(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cointop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	portfolio := map[string]interface{}{
+		"holdings": []interface{}{[]interface{}{"Bitcoin", "1.5"}},
+	}
+	priceAlerts := map[string]interface{}{
+		"alerts": []interface{}{[]interface{}{"Bitcoin", ">", "50000", "once"}},
+		"sound":  true,
+	}
+	ct := &Cointop{
+		State:          &State{dataDir: dir},
+		configFilepath: filepath.Join(dir, "config", "config.toml"),
+		config: config{
+			PortfolioFile: "portfolio.toml",
+			AlertsFile:    "alerts.toml",
+			Portfolio:     portfolio,
+			PriceAlerts:   priceAlerts,
+		},
+	}
+	if err := ct.loadSectionFilesFromConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if ct.portfolioFile != "portfolio.toml" || ct.alertsFile != "alerts.toml" {
+		t.Errorf("files == %q %q, want the files of the config", ct.portfolioFile, ct.alertsFile)
+	}
+	for _, name := range []string{"portfolio.toml", "alerts.toml"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s to be migrated: %s", name, err)
+		}
+	}
+	if !reflect.DeepEqual(ct.config.Portfolio, portfolio) {
+		t.Errorf("portfolio == %v, want %v", ct.config.Portfolio, portfolio)
+	}
+	if !reflect.DeepEqual(ct.config.PriceAlerts, priceAlerts) {
+		t.Errorf("price alerts == %v, want %v", ct.config.PriceAlerts, priceAlerts)
+	}
+
+	// NOTE: the files win over a stale inline section left in the main config
+	ct.config = config{
+		PortfolioFile: "portfolio.toml",
+		Portfolio: map[string]interface{}{
+			"holdings": []interface{}{[]interface{}{"Ethereum", "3"}},
+		},
+	}
+	if err := ct.loadSectionFilesFromConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ct.config.Portfolio, portfolio) {
+		t.Errorf("portfolio == %v, want the migrated %v", ct.config.Portfolio, portfolio)
+	}
+}
+
+// TestInlineSections checks that the sections stay in the main config when the file paths are unset or empty
+func TestInlineSections(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cointop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, file := range []interface{}{nil, ""} {
+		ct := &Cointop{
+			State: &State{dataDir: dir},
+			config: config{
+				PortfolioFile: file,
+				AlertsFile:    file,
+				Portfolio:     map[string]interface{}{"holdings": []interface{}{}},
+				PriceAlerts:   map[string]interface{}{"alerts": []interface{}{}},
+			},
+		}
+		if err := ct.loadSectionFilesFromConfig(); err != nil {
+			t.Fatal(err)
+		}
+		if ct.portfolioFile != "" || ct.alertsFile != "" {
+			t.Errorf("files == %q %q, want none for %#v", ct.portfolioFile, ct.alertsFile, file)
+		}
+		if files, _ := ioutil.ReadDir(dir); len(files) != 0 {
+			t.Errorf("files == %d, want none written for %#v", len(files), file)
+		}
+	}
+}
+
+// TestMigrateLegacySectionFile checks that a relative section file is moved from the config directory to the data
+// directory
+func TestMigrateLegacySectionFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cointop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configDir := filepath.Join(dir, "config")
+	dataDir := filepath.Join(dir, "data")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	legacy := "[portfolio]\n  holdings = [[\"Bitcoin\", \"2\"]]\n"
+	if err := ioutil.WriteFile(filepath.Join(configDir, "holdings.toml"), []byte(legacy), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ct := &Cointop{
+		State:          &State{dataDir: dataDir},
+		configFilepath: filepath.Join(configDir, "config.toml"),
+		config:         config{PortfolioFile: "holdings.toml", AlertsFile: ""},
+	}
+	if err := ct.loadSectionFilesFromConfig(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, "holdings.toml")); err != nil {
+		t.Errorf("expected the file to be moved to the data directory: %s", err)
+	}
+	if _, err := os.Stat(filepath.Join(configDir, "holdings.toml")); !os.IsNotExist(err) {
+		t.Errorf("expected the legacy file to be removed: %v", err)
+	}
+	want := []interface{}{[]interface{}{"Bitcoin", "2"}}
+	if !reflect.DeepEqual(ct.config.Portfolio["holdings"], want) {
+		t.Errorf("holdings == %v, want %v", ct.config.Portfolio["holdings"], want)
+	}
+}
+
+// TestConfigCurrency checks that the currency is read from the config file and defaults to USD
+func TestConfigCurrency(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cointop")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.toml")
+	if currency, err := ConfigCurrency(path); err != nil || currency != "USD" {
+		t.Errorf("currency == %q, %v, want USD without a config file", currency, err)
+	}
+	if err := ioutil.WriteFile(path, []byte("currency = \"eur\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if currency, err := ConfigCurrency(path); err != nil || currency != "EUR" {
+		t.Errorf("currency == %q, %v, want EUR", currency, err)
+	}
+}
+
+// TestPerPageValidation checks that the per page of the flag and of the config is between 1 and MaxPerPage
+func TestPerPageValidation(t *testing.T) {
+	if _, err := NewCointop(&Config{PerPage: MaxPerPage + 1}); err == nil {
+		t.Errorf("expected an error for the per page flag %d", MaxPerPage+1)
+	}
+
+	ct := &Cointop{
+		config: config{Table: map[string]interface{}{"per_page": int64(MaxPerPage + 1)}},
+		State:  &State{perPage: 100},
+	}
+	if err := ct.loadTableConfig(); err == nil {
+		t.Errorf("expected an error for the per_page config %d", MaxPerPage+1)
+	}
+	ct.config.Table["per_page"] = int64(50)
+	if err := ct.loadTableConfig(); err != nil || ct.State.perPage != 50 {
+		t.Errorf("per page == %d, %v, want 50", ct.State.perPage, err)
+	}
+}
@@ -6,6 +6,12 @@ const CoinMarketCap = "coinmarketcap"
 // CoinGecko is API choice
 const CoinGecko = "coingecko"
 
+// CoinPaprika is API choice
+const CoinPaprika = "coinpaprika"
+
+// Demo is the API choice of demo mode
+const Demo = "demo"
+
 // PortfolioView is portfolio table constant
 const PortfolioView = "portfolio"
 
@@ -17,3 +23,12 @@ const FavoritesView = "favorites"
 
 // PriceAlertsView is price alerts table constant
 const PriceAlertsView = "price_alerts"
+
+// RecentlyAddedView is recently added coins table constant
+const RecentlyAddedView = "recently_added"
+
+// GainersView is top gainers table constant
+const GainersView = "gainers"
+
+// LosersView is top losers table constant
+const LosersView = "losers"
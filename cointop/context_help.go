@@ -0,0 +1,62 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+)
+
+// viewTitles are the titles of the table views shown in the context help
+var viewTitles = map[string]string{
+	CoinsView:         "Coins",
+	FavoritesView:     "Favorites",
+	PortfolioView:     "Portfolio",
+	PriceAlertsView:   "Price Alerts",
+	RecentlyAddedView: "Recently Added",
+	GainersView:       "Top Gainers",
+	LosersView:        "Top Losers",
+}
+
+// ContextShortcuts returns the shortcuts bound to the actions of the selected view
+func (ct *Cointop) ContextShortcuts() map[string]string {
+	actions := make(map[string]bool)
+	for _, action := range ViewActionsMap()[ct.State.selectedView] {
+		actions[action] = true
+	}
+
+	shortcuts := make(map[string]string)
+	for k, action := range ct.State.shortcutKeys {
+		if actions[action] {
+			shortcuts[k] = action
+		}
+	}
+	return shortcuts
+}
+
+// UpdateContextHelp updates the help view with the shortcuts of the selected view
+func (ct *Cointop) UpdateContextHelp() {
+	ct.debuglog("updateContextHelp()")
+	title, ok := viewTitles[ct.State.selectedView]
+	if !ok {
+		title = ct.State.selectedView
+	}
+	ct.updateHelpMenu(fmt.Sprintf("Help - %s", title), fmt.Sprintf("Keyboard shortcuts of the %s view", strings.ToLower(title)), ct.ContextShortcuts())
+}
+
+// ShowContextHelp shows the help view with only the shortcuts of the selected view
+func (ct *Cointop) ShowContextHelp() error {
+	ct.debuglog("showContextHelp()")
+	ct.State.helpVisible = true
+	ct.State.contextHelpVisible = true
+	ct.UpdateContextHelp()
+	ct.SetActiveView(ct.Views.Menu.Name())
+	return nil
+}
+
+// ToggleContextHelp toggles the context help view
+func (ct *Cointop) ToggleContextHelp() error {
+	ct.debuglog("toggleContextHelp()")
+	if ct.State.contextHelpVisible {
+		return ct.HideHelp()
+	}
+	return ct.ShowContextHelp()
+}
@@ -116,7 +116,7 @@ func (ct *Cointop) IsSupportedCurrencyConversion(convert string) bool {
 // SupportedCurrencyConversions returns a map of all supported currencies for conversion
 func (ct *Cointop) SupportedCurrencyConversions() map[string]string {
 	all := map[string]string{}
-	for _, symbol := range ct.api.SupportedCurrencies() {
+	for _, symbol := range ct.API().SupportedCurrencies() {
 		if v, ok := FiatCurrencyNames[symbol]; ok {
 			all[symbol] = v
 		}
@@ -228,11 +228,17 @@ func (ct *Cointop) SetCurrencyConverstion(convert string) error {
 func (ct *Cointop) SetCurrencyConverstionFn(convert string) func() error {
 	ct.debuglog("setCurrencyConverstionFn()")
 	return func() error {
+		// NOTE: the option keys are bound on the menu view which other menus use too
+		if !ct.State.convertMenuVisible {
+			return nil
+		}
 		ct.HideConvertMenu()
 
 		if err := ct.SetCurrencyConverstion(convert); err != nil {
 			return err
 		}
+		// NOTE: picking a currency ends the base currency toggle
+		ct.State.displayCurrency = ""
 
 		if err := ct.Save(); err != nil {
 			return err
@@ -243,6 +249,37 @@ func (ct *Cointop) SetCurrencyConverstionFn(convert string) func() error {
 	}
 }
 
+// ToggleBaseCurrency switches the currency conversion to the base currency and back to the display currency
+func (ct *Cointop) ToggleBaseCurrency() error {
+	ct.debuglog("toggleBaseCurrency()")
+	if ct.State.displayCurrency != "" {
+		if err := ct.SetCurrencyConverstion(ct.State.displayCurrency); err != nil {
+			return err
+		}
+		ct.State.displayCurrency = ""
+	} else {
+		if ct.State.currencyConversion == ct.State.baseCurrency {
+			return nil
+		}
+		displayCurrency := ct.State.currencyConversion
+		if err := ct.SetCurrencyConverstion(ct.State.baseCurrency); err != nil {
+			return err
+		}
+		ct.State.displayCurrency = displayCurrency
+	}
+
+	go ct.RefreshAll()
+	return nil
+}
+
+// SavedCurrencyConversion returns the currency conversion to save, which is the display currency while the base currency is toggled on
+func (ct *Cointop) SavedCurrencyConversion() string {
+	if ct.State.displayCurrency != "" {
+		return ct.State.displayCurrency
+	}
+	return ct.State.currencyConversion
+}
+
 // CurrencySymbol returns the symbol for the currency conversion
 func (ct *Cointop) CurrencySymbol() string {
 	ct.debuglog("currencySymbol()")
@@ -1,9 +1,12 @@
 package cointop
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"time"
+
+	"github.com/miguelmota/cointop/pkg/pad"
 )
 
 // debuglog writs a debug log to stdout
@@ -25,3 +28,97 @@ func (ct *Cointop) debuglog(msg string) {
 		panic(err)
 	}
 }
+
+// debugCoin returns the coin to show in the coin debug view
+func (ct *Cointop) debugCoin() *Coin {
+	if coin := ct.HighlightedRowCoin(); coin != nil {
+		return coin
+	}
+
+	return ct.State.selectedCoin
+}
+
+// UpdateCoinDebug updates the coin debug view with the coin data as JSON
+func (ct *Cointop) UpdateCoinDebug() error {
+	ct.debuglog("updateCoinDebug()")
+	coin := ct.debugCoin()
+	if coin == nil {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(coin, " ", "  ")
+	if err != nil {
+		return err
+	}
+
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Coin Data %s\n\n", pad.Left("[q] close ", ct.width()-14, " ")))
+	label := ct.colorscheme.MenuLabel(fmt.Sprintf("%s (%s)", coin.Name, coin.Symbol))
+	content := fmt.Sprintf("%s %s\n\n %s\n\n [↑/↓] Scroll    [ESC] Close", header, label, string(b))
+
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(true)
+		ct.Views.Menu.SetOrigin(0, 0)
+		return ct.Views.Menu.Update(content)
+	})
+	return nil
+}
+
+// ShowCoinDebug shows the raw data of the highlighted coin
+func (ct *Cointop) ShowCoinDebug() error {
+	ct.debuglog("showCoinDebug()")
+	if !ct.debug {
+		ct.UpdateStatusbar("Run with DEBUG=1 to view coin data")
+		return nil
+	}
+	if ct.debugCoin() == nil {
+		return nil
+	}
+
+	ct.State.coinDebugVisible = true
+	ct.UpdateCoinDebug()
+	ct.SetActiveView(ct.Views.Menu.Name())
+	return nil
+}
+
+// HideCoinDebug hides the coin debug view
+func (ct *Cointop) HideCoinDebug() error {
+	ct.debuglog("hideCoinDebug()")
+	if !ct.State.coinDebugVisible {
+		return nil
+	}
+
+	ct.State.coinDebugVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		ct.Views.Menu.SetOrigin(0, 0)
+		return ct.Views.Menu.Update("")
+	})
+	return nil
+}
+
+// ScrollCoinDebugFn returns a function that scrolls the coin debug view by the given number of lines
+func (ct *Cointop) ScrollCoinDebugFn(lines int) func() error {
+	return func() error {
+		if !ct.State.coinDebugVisible {
+			return nil
+		}
+
+		ct.UpdateUI(func() error {
+			if !ct.Views.Menu.HasBacking() {
+				return nil
+			}
+			y := ct.Views.Menu.OriginY() + lines
+			max := len(ct.Views.Menu.Backing().BufferLines()) - ct.Views.Menu.Height()
+			if y > max {
+				y = max
+			}
+			if y < 0 {
+				y = 0
+			}
+			return ct.Views.Menu.SetOrigin(0, y)
+		})
+		return nil
+	}
+}
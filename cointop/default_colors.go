@@ -11,6 +11,38 @@ chart_fg = "white"
 chart_bg = "black"
 chart_bold = false
 
+chart_overlay_fg = "yellow"
+chart_overlay_bg = "black"
+chart_overlay_bold = false
+
+chart_candle_up_fg = "green"
+chart_candle_up_bg = "black"
+chart_candle_up_bold = false
+
+chart_candle_down_fg = "red"
+chart_candle_down_bg = "black"
+chart_candle_down_bold = false
+
+chart_series_1_fg = "cyan"
+chart_series_1_bg = "black"
+chart_series_1_bold = false
+
+chart_series_2_fg = "magenta"
+chart_series_2_bg = "black"
+chart_series_2_bold = false
+
+chart_series_3_fg = "green"
+chart_series_3_bg = "black"
+chart_series_3_bold = false
+
+chart_series_4_fg = "yellow"
+chart_series_4_bg = "black"
+chart_series_4_bold = false
+
+chart_series_5_fg = "red"
+chart_series_5_bg = "black"
+chart_series_5_bold = false
+
 marketbar_fg = "white"
 marketbar_bg = "black"
 marketbar_bold = false
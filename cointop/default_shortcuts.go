@@ -15,24 +15,33 @@ func DefaultShortcuts() map[string]string {
 		"esc":       "quit_view",
 		"space":     "toggle_favorite",
 		"tab":       "move_down_or_next_page",
+		"ctrl+b":    "toggle_losers",
 		"ctrl+c":    "quit",
 		"ctrl+C":    "quit",
 		"ctrl+d":    "page_down",
+		"ctrl+e":    "export_chart_csv",
 		"ctrl+f":    "open_search",
+		"ctrl+g":    "show_coin_debug",
 		"ctrl+n":    "next_page",
 		"ctrl+p":    "previous_page",
 		"ctrl+r":    "refresh",
 		"ctrl+R":    "refresh",
 		"ctrl+s":    "save",
 		"ctrl+S":    "save",
+		"ctrl+t":    "toggle_rank_column",
 		"ctrl+u":    "page_up",
+		"ctrl+v":    "show_coin_value",
+		"ctrl+w":    "toggle_gainers",
 		"ctrl+j":    "enlarge_chart",
 		"ctrl+k":    "shorten_chart",
+		"ctrl+l":    "toggle_chart_pin",
+		"ctrl+o":    "show_favorite_group_menu",
 		"alt+up":    "sort_column_asc",
 		"alt+down":  "sort_column_desc",
 		"alt+left":  "sort_left_column",
 		"alt+right": "sort_right_column",
 		"F1":        "help",
+		"F2":        "context_help",
 		"F5":        "refresh",
 		"0":         "first_page",
 		"1":         "sort_column_1h_change",
@@ -41,8 +50,10 @@ func DefaultShortcuts() map[string]string {
 		"7":         "sort_column_7d_change",
 		"a":         "sort_column_available_supply",
 		"b":         "sort_column_balance",
+		"B":         "toggle_base_currency",
 		"c":         "show_currency_convert_menu",
 		"C":         "show_currency_convert_menu",
+		"D":         "show_column_menu",
 		"e":         "show_portfolio_edit_menu",
 		"E":         "show_portfolio_edit_menu",
 		"A":         "toggle_price_alerts",
@@ -50,6 +61,7 @@ func DefaultShortcuts() map[string]string {
 		"F":         "toggle_show_favorites",
 		"g":         "move_to_page_first_row",
 		"G":         "move_to_page_last_row",
+		"i":         "toggle_infobar",
 		"h":         "previous_page",
 		"H":         "move_to_page_visible_first_row",
 		"j":         "move_down",
@@ -59,6 +71,7 @@ func DefaultShortcuts() map[string]string {
 		"m":         "sort_column_market_cap",
 		"M":         "move_to_page_visible_middle_row",
 		"n":         "sort_column_name",
+		"N":         "toggle_recently_added",
 		"o":         "open_link",
 		"O":         "open_link",
 		"p":         "sort_column_price",
@@ -66,11 +79,37 @@ func DefaultShortcuts() map[string]string {
 		"r":         "sort_column_rank",
 		"s":         "sort_column_symbol",
 		"t":         "sort_column_total_supply",
+		"T":         "toggle_ticker",
 		"u":         "sort_column_last_updated",
 		"v":         "sort_column_24h_volume",
+		"V":         "toggle_chart_volume",
+		"w":         "move_to_top_gainer",
+		"W":         "move_to_top_loser",
+		"x":         "cycle_change_window",
+		"X":         "blacklist_coin",
+		"z":         "toggle_mark",
+		"Z":         "open_marked_links",
+		"y":         "copy_link",
+		"Y":         "export_table_to_markdown",
 		"q":         "quit_view",
 		"Q":         "quit_view",
 		"%":         "sort_column_percent_holdings",
+		"*":         "toggle_favorites_summary",
+		"~":         "go_home",
+		"=":         "add_to_portfolio",
+		"U":         "undo_removal",
+		"@":         "toggle_volume_units",
+		"ctrl+x":    "toggle_privacy_mode",
+		"^":         "toggle_chart_sma",
+		"K":         "toggle_chart_candles",
+		"S":         "toggle_chart_log_scale",
+		"&":         "toggle_chart_compare",
+		"R":         "full_refresh",
+		".":         "toggle_pause_refresh",
+		"#":         "show_per_page_menu",
+		":":         "show_go_to_rank_menu",
+		"|":         "show_filter_menu",
+		"!":         "show_market_cap_menu",
 		"$":         "last_page",
 		"?":         "help",
 		"/":         "open_search",
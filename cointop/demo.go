@@ -0,0 +1,34 @@
+package cointop
+
+import (
+	"github.com/miguelmota/cointop/pkg/api/types"
+)
+
+// DemoStatus is shown in the statusbar while in demo mode
+const DemoStatus = "Demo: showing sample data"
+
+// IsDemoMode returns true if cointop shows the fixture data of demo mode instead of fetching from an API
+func (ct *Cointop) IsDemoMode() bool {
+	return ct.demoMode
+}
+
+// loadDemoCoins loads the fixture coins of the demo API into the coins map so the table is filled on startup
+func (ct *Cointop) loadDemoCoins(allCoinsSlugMap map[string]*Coin) error {
+	ct.debuglog("loadDemoCoins()")
+	// NOTE: the demo data only has a few currencies so the others fall back to USD
+	if !ct.IsSupportedCurrencyConversion(ct.State.currencyConversion) {
+		ct.State.currencyConversion = "USD"
+	}
+	ch := make(chan []types.Coin)
+	if err := ct.API().GetAllCoinData(ct.State.currencyConversion, ch); err != nil {
+		return err
+	}
+
+	for coins := range ch {
+		for _, v := range coins {
+			allCoinsSlugMap[v.Name] = coinFromAPI(v)
+		}
+	}
+
+	return nil
+}
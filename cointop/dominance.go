@@ -20,9 +20,11 @@ func PrintBitcoinDominance(config *DominanceConfig) error {
 
 	var coinAPI api.Interface
 	if config.APIChoice == CoinMarketCap {
-		coinAPI = api.NewCMC("")
+		coinAPI = api.NewCMC("", "")
 	} else if config.APIChoice == CoinGecko {
-		coinAPI = api.NewCG()
+		coinAPI = api.NewCG(false, 0, nil, 0)
+	} else if config.APIChoice == CoinPaprika {
+		coinAPI = api.NewCP()
 	} else {
 		return ErrInvalidAPIChoice
 	}
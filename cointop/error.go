@@ -5,5 +5,14 @@ import "errors"
 // ErrInvalidAPIChoice is error for invalid API choice
 var ErrInvalidAPIChoice = errors.New("invalid API choice")
 
+// ErrNoAvailableAPI is error for when none of the preferred APIs are reachable
+var ErrNoAvailableAPI = errors.New("no available API")
+
+// ErrPingTimeout is error for when the API doesn't respond to a ping in time
+var ErrPingTimeout = errors.New("API ping timed out")
+
+// ErrOffline is error for when data isn't fetched because cointop is in offline mode
+var ErrOffline = errors.New("offline")
+
 // ErrCoinNameOrSymbolRequired is error for when coin name or symbol is required
 var ErrCoinNameOrSymbolRequired = errors.New("coin name or symbol is required")
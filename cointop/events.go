@@ -4,4 +4,6 @@ package cointop
 func (ct *Cointop) RowChanged() {
 	ct.debuglog("RowChanged()")
 	ct.RefreshRowLink()
+	ct.UpdateTerminalTitle()
+	ct.UpdateInfobar()
 }
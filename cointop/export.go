@@ -0,0 +1,73 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/clipboard"
+)
+
+// TableMarkdown returns the rows of the active table view as a markdown table
+func (ct *Cointop) TableMarkdown() string {
+	ct.debuglog("tableMarkdown()")
+	if ct.table == nil {
+		return ""
+	}
+
+	headers := ct.GetActiveTableHeaders()
+	var labels []string
+	var aligns []string
+	for _, col := range headers {
+		hc, ok := headerColumn(col)
+		if !ok || ct.GetTableColumnWidth(col) == 0 {
+			continue
+		}
+		label := hc.PlainLabel
+		switch col {
+		case "price", "ath", "balance", "cost_price", "cost", "pnl":
+			label = ct.CurrencySymbol() + label
+		case "change":
+			label = ct.State.changeWindow + " " + label
+		}
+		labels = append(labels, label)
+		if ct.GetTableColumnAlignLeft(col) {
+			aligns = append(aligns, "---")
+		} else {
+			aligns = append(aligns, "---:")
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(labels, " | "))
+	fmt.Fprintf(&b, "| %s |\n", strings.Join(aligns, " | "))
+	for _, row := range ct.table.RowCells() {
+		// pad or cut the row to the header so a missing cell doesn't shift the columns
+		texts := make([]string, len(labels))
+		for i, cell := range row {
+			if i >= len(texts) {
+				break
+			}
+			text := strings.Join(strings.Fields(stripANSI(cell.Text)), " ")
+			texts[i] = strings.Replace(text, "|", "\\|", -1)
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(texts, " | "))
+	}
+
+	return b.String()
+}
+
+// ExportTableToMarkdown copies the active table view to the clipboard as a markdown table
+func (ct *Cointop) ExportTableToMarkdown() error {
+	ct.debuglog("exportTableToMarkdown()")
+	if ct.table == nil || ct.table.RowCount() == 0 {
+		return nil
+	}
+
+	if err := clipboard.Copy(ct.TableMarkdown()); err != nil {
+		ct.UpdateStatusbar(fmt.Sprintf("Could not copy table: %s", err))
+		return nil
+	}
+
+	ct.UpdateStatusbar(fmt.Sprintf("Copied %d rows as markdown", ct.table.RowCount()))
+	return nil
+}
@@ -0,0 +1,29 @@
+package cointop
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/miguelmota/cointop/pkg/table"
+)
+
+// TestTableMarkdownSkippedColumn checks that a column without cells is left out of the header so the cells stay aligned
+func TestTableMarkdownSkippedColumn(t *testing.T) {
+	ct := &Cointop{
+		State: &State{
+			coinsTableColumns: []string{"rank", "holdings", "name"},
+		},
+		table: table.NewTable(),
+	}
+	ct.SetTableColumnWidth("rank", 4)
+	ct.SetTableColumnWidth("name", 10)
+	ct.table.AddRowCells(
+		&table.RowCell{Color: fmt.Sprint, Text: "\x1b[32m1\x1b[0m"},
+		&table.RowCell{Color: fmt.Sprint, Text: "Bitcoin"},
+	)
+
+	want := "| rank | name |\n| ---: | ---: |\n| 1 | Bitcoin |\n"
+	if got := ct.TableMarkdown(); got != want {
+		t.Errorf("markdown == %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,210 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// DefaultFavoriteGroup is the section of the favorites that aren't assigned to a group
+const DefaultFavoriteGroup = "Ungrouped"
+
+// FavoriteGroup returns the group of the favorite coin, or the default group if it isn't assigned to one
+func (ct *Cointop) FavoriteGroup(coin *Coin) string {
+	if group, ok := ct.State.favoriteGroups[coin.Name]; ok {
+		return group
+	}
+	return DefaultFavoriteGroup
+}
+
+// HasFavoriteGroups returns true if any favorite is assigned to a group
+func (ct *Cointop) HasFavoriteGroups() bool {
+	for name := range ct.State.favoriteGroups {
+		if ct.State.favorites[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// FavoriteGroupNames returns the names of the groups of the favorites in alphabetical order
+func (ct *Cointop) FavoriteGroupNames() []string {
+	seen := make(map[string]bool)
+	var names []string
+	for name, group := range ct.State.favoriteGroups {
+		if ct.State.favorites[name] && !seen[group] {
+			seen[group] = true
+			names = append(names, group)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SectionFavorites orders the favorites by group, keeping the sort order within each group.
+// The groups are in alphabetical order followed by the favorites that aren't assigned to a group
+func (ct *Cointop) SectionFavorites(coins []*Coin) {
+	order := make(map[string]int)
+	for i, group := range ct.FavoriteGroupNames() {
+		order[group] = i
+	}
+	section := func(coin *Coin) int {
+		if i, ok := order[ct.FavoriteGroup(coin)]; ok {
+			return i
+		}
+		return len(order)
+	}
+	sort.SliceStable(coins, func(i, j int) bool {
+		return section(coins[i]) < section(coins[j])
+	})
+}
+
+// favoriteGroupLabel returns the group name for the first row of its section and blank for the rest
+func (ct *Cointop) favoriteGroupLabel(coins []*Coin, i int) string {
+	group := ct.FavoriteGroup(coins[i])
+	if i > 0 && coins[i-1] != nil && ct.FavoriteGroup(coins[i-1]) == group {
+		return ""
+	}
+	return group
+}
+
+// SetFavoriteGroup assigns the favorite coin to the group. An empty group moves it to the default group
+func (ct *Cointop) SetFavoriteGroup(coin *Coin, group string) error {
+	ct.debuglog("setFavoriteGroup()")
+	group = strings.TrimSpace(group)
+	if group == "" || group == DefaultFavoriteGroup {
+		delete(ct.State.favoriteGroups, coin.Name)
+	} else {
+		ct.State.favoriteGroups[coin.Name] = group
+	}
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go ct.UpdateTable()
+	return nil
+}
+
+// UpdateFavoriteGroupMenu updates the favorite group menu view
+func (ct *Cointop) UpdateFavoriteGroupMenu(coin *Coin) error {
+	ct.debuglog("updateFavoriteGroupMenu()")
+	value := ""
+	if group, ok := ct.State.favoriteGroups[coin.Name]; ok {
+		value = group
+	}
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Favorite Group %s\n\n", pad.Left("[q] close ", ct.width()-16, " ")))
+	label := fmt.Sprintf(" Enter the group of %s %s", coin.Name, ct.colorscheme.MenuLabel("(empty for ungrouped)"))
+	var groupsText string
+	if groups := ct.FavoriteGroupNames(); len(groups) > 0 {
+		groupsText = fmt.Sprintf("\n\n Groups: %s", strings.Join(groups, ", "))
+	}
+	content := fmt.Sprintf("%s\n%s\n\n\n\n\n [Enter] Set    [ESC] Cancel%s", header, label, groupsText)
+
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(true)
+		ct.Views.Menu.Update(content)
+		ct.Views.Input.Update(value)
+		ct.Views.Input.SetCursor(utf8.RuneCountInString(value), 0)
+		return nil
+	})
+	return nil
+}
+
+// ShowFavoriteGroupMenu shows the menu to assign the highlighted favorite to a group
+func (ct *Cointop) ShowFavoriteGroupMenu() error {
+	ct.debuglog("showFavoriteGroupMenu()")
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return nil
+	}
+	if !ct.State.favorites[coin.Name] {
+		return ct.UpdateStatusbar(fmt.Sprintf("%s isn't a favorite", coin.Name))
+	}
+
+	ct.State.favoriteGroupCoin = coin
+	ct.State.favoriteGroupMenuVisible = true
+	ct.UpdateFavoriteGroupMenu(coin)
+	ct.ui.SetCursor(true)
+	ct.SetActiveView(ct.Views.Menu.Name())
+	ct.g.SetViewOnTop(ct.Views.Input.Name())
+	ct.g.SetCurrentView(ct.Views.Input.Name())
+	return nil
+}
+
+// HideFavoriteGroupMenu hides the favorite group menu
+func (ct *Cointop) HideFavoriteGroupMenu() error {
+	ct.debuglog("hideFavoriteGroupMenu()")
+	ct.State.favoriteGroupCoin = nil
+	ct.State.favoriteGroupMenuVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.ui.SetViewOnBottom(ct.Views.Input)
+	ct.ui.SetCursor(false)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		ct.Views.Menu.Update("")
+		ct.Views.Input.Update("")
+		return nil
+	})
+
+	return nil
+}
+
+// SetFavoriteGroupFromInput assigns the favorite to the inputed group
+func (ct *Cointop) SetFavoriteGroupFromInput() error {
+	ct.debuglog("setFavoriteGroupFromInput()")
+	coin := ct.State.favoriteGroupCoin
+	ct.Views.Input.Rewind()
+	b := make([]byte, 100)
+	n, _ := ct.Views.Input.Read(b)
+	value := string(b[:n])
+	ct.HideFavoriteGroupMenu()
+	if coin == nil {
+		return nil
+	}
+	return ct.SetFavoriteGroup(coin, value)
+}
+
+// favoriteGroupsToToml returns the groups config table with the names of the favorites of each group
+func (ct *Cointop) favoriteGroupsToToml() map[string]interface{} {
+	groups := make(map[string][]string)
+	for name, group := range ct.State.favoriteGroups {
+		if ct.State.favorites[name] {
+			groups[group] = append(groups[group], name)
+		}
+	}
+	groupsIfc := make(map[string]interface{})
+	for group, names := range groups {
+		sort.Strings(names)
+		groupsIfc[group] = names
+	}
+	return groupsIfc
+}
+
+// loadFavoriteGroupsFromConfig loads the favorite groups from the config table of group names to favorite names
+func (ct *Cointop) loadFavoriteGroupsFromConfig(valueIfc interface{}) error {
+	ct.debuglog("loadFavoriteGroupsFromConfig()")
+	groupsIfc, ok := valueIfc.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	for group, namesIfc := range groupsIfc {
+		ifcs, ok := namesIfc.([]interface{})
+		if !ok {
+			return fmt.Errorf("invalid favorites group %q. Expected a list of coin names", group)
+		}
+		if strings.TrimSpace(group) == "" {
+			continue
+		}
+		for _, ifc := range ifcs {
+			if name, ok := ifc.(string); ok {
+				ct.State.favoriteGroups[name] = group
+			}
+		}
+	}
+	return nil
+}
@@ -1,31 +1,55 @@
 package cointop
 
 import (
+	"fmt"
 	"sort"
 )
 
-// GetFavoritesTableHeaders returns the favorites table headers
+// FavoritesHighlightColor colors the names of favorite coins
+const FavoritesHighlightColor = "color"
+
+// FavoritesHighlightStar colors the names of favorite coins and prefixes them with a star
+const FavoritesHighlightStar = "star"
+
+// FavoritesHighlightNone doesn't mark favorite coins
+const FavoritesHighlightNone = "none"
+
+// FavoriteStar is the prefix of favorite coin names when highlighted with a star
+const FavoriteStar = "★"
+
+// GetFavoritesTableHeaders returns the favorites table headers. The group column is prepended when favorites are grouped
 func (ct *Cointop) GetFavoritesTableHeaders() []string {
-	return ct.State.favoritesTableColumns
+	headers := ct.withPriceCurrencyColumns(ct.State.favoritesTableColumns)
+	if ct.HasFavoriteGroups() {
+		headers = append([]string{"group"}, headers...)
+	}
+	return headers
 }
 
 // ToggleFavorite toggles coin as favorite
 func (ct *Cointop) ToggleFavorite() error {
 	ct.debuglog("toggleFavorite()")
-	coin := ct.HighlightedRowCoin()
+	return ct.toggleFavoriteCoin(ct.HighlightedRowCoin())
+}
+
+// toggleFavoriteCoin toggles the coin as favorite
+func (ct *Cointop) toggleFavoriteCoin(coin *Coin) error {
 	if coin == nil {
 		return nil
 	}
 
-	_, ok := ct.State.favorites[coin.Name]
-	if ok {
-		delete(ct.State.favorites, coin.Name)
-		coin.Favorite = false
-	} else {
-		ct.State.favorites[coin.Name] = true
-		coin.Favorite = true
+	if _, ok := ct.State.favorites[coin.Name]; ok {
+		if ct.State.confirmRemoval {
+			return ct.ShowConfirmMenu(fmt.Sprintf("Remove %s from favorites?", coin.Name), func() error {
+				return ct.removeFavoriteCoin(coin)
+			})
+		}
+		return ct.removeFavoriteCoin(coin)
 	}
 
+	ct.State.favorites[coin.Name] = true
+	coin.Favorite = true
+
 	if err := ct.Save(); err != nil {
 		return err
 	}
@@ -73,6 +97,36 @@ func (ct *Cointop) GetFavoritesSlice() []*Coin {
 	return sliced
 }
 
+// FavoritesSummary returns the number of favorites that have coin data and their average 24h change.
+// NOTE: it takes the coins lock so the statusbar must not be updated synchronously while the lock is held
+func (ct *Cointop) FavoritesSummary() (int, float64) {
+	var count int
+	var total float64
+	coinslock.Lock()
+	defer coinslock.Unlock()
+	for _, coin := range ct.State.allCoins {
+		if coin.Favorite {
+			count++
+			total += coin.PercentChange24H
+		}
+	}
+	if count == 0 {
+		return 0, 0
+	}
+	return count, total / float64(count)
+}
+
+// ToggleFavoritesSummary shows or hides the favorites summary in the statusbar
+func (ct *Cointop) ToggleFavoritesSummary() error {
+	ct.debuglog("toggleFavoritesSummary()")
+	ct.State.favoritesSummary = !ct.State.favoritesSummary
+	go ct.UpdateStatusbar("")
+	if err := ct.Save(); err != nil {
+		return err
+	}
+	return nil
+}
+
 // IsFavoritesVisible returns true if favorites view is visible
 func (ct *Cointop) IsFavoritesVisible() bool {
 	return ct.State.selectedView == FavoritesView
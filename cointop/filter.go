@@ -0,0 +1,154 @@
+package cointop
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// FilterCoins shows only the coins whose name or symbol match the regular expression in the coins table.
+// An empty pattern shows all the coins again
+func (ct *Cointop) FilterCoins(pattern string) error {
+	ct.debuglog("filterCoins()")
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		ct.State.coinsFilter = nil
+	} else {
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			ct.UpdateStatusbar(fmt.Sprintf("Invalid filter %q: %s", pattern, err))
+			return nil
+		}
+		ct.State.coinsFilter = re
+	}
+
+	ct.State.page = 0
+	ct.UpdateTable()
+	return ct.HighlightRow(0)
+}
+
+// IsCoinsFiltered returns true if the coins table only shows the coins matching the pattern or market cap filters
+func (ct *Cointop) IsCoinsFiltered() bool {
+	return (ct.State.coinsFilter != nil || ct.IsMarketCapFiltered()) && ct.State.selectedView == CoinsView
+}
+
+// filterCoins returns the coins matching the filters
+func (ct *Cointop) filterCoins(coins []*Coin) []*Coin {
+	var list []*Coin
+	for _, coin := range coins {
+		if re := ct.State.coinsFilter; re != nil && !re.MatchString(coin.Name) && !re.MatchString(coin.Symbol) {
+			continue
+		}
+		if !ct.inMarketCapRange(coin) {
+			continue
+		}
+		list = append(list, coin)
+	}
+	return list
+}
+
+// filteredCoinsSlice returns the page of the filtered coins. Unlike the full list, the last coin of a short list
+// is kept since a filter often matches only a few coins
+func (ct *Cointop) filteredCoinsSlice(coins []*Coin) []*Coin {
+	start := ct.State.page * ct.State.perPage
+	if start >= len(coins) {
+		return nil
+	}
+	end := start + ct.State.perPage
+	if end > len(coins) {
+		end = len(coins)
+	}
+	return coins[start:end]
+}
+
+// goToCoinIndex navigates to the coin at the index of all the coins, as returned by search. The filters are
+// cleared if they hide the coin
+func (ct *Cointop) goToCoinIndex(idx int) error {
+	if !ct.IsCoinsFiltered() {
+		return ct.GoToGlobalIndex(idx)
+	}
+
+	// NOTE: the last page of the filtered coins is often short so the page is found from the number of rows per page
+	coin := ct.State.allCoins[idx]
+	i := -1
+	for j, v := range ct.AllCoins() {
+		if v == coin {
+			i = j
+			break
+		}
+	}
+	if i == -1 {
+		ct.State.coinsFilter = nil
+		ct.State.marketCapMin = 0
+		ct.State.marketCapMax = 0
+		i = idx
+	}
+	ct.State.page = i / ct.State.perPage
+	ct.UpdateTable()
+	return ct.HighlightRow(i % ct.State.perPage)
+}
+
+// UpdateFilterMenu updates the coins filter menu view
+func (ct *Cointop) UpdateFilterMenu() error {
+	ct.debuglog("updateFilterMenu()")
+	var value string
+	if ct.IsCoinsFiltered() {
+		value = strings.TrimPrefix(ct.State.coinsFilter.String(), "(?i)")
+	}
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Filter Coins %s\n\n", pad.Left("[q] close ", ct.width()-14, " ")))
+	label := fmt.Sprintf(" Enter a regular expression to match coin names and symbols %s", ct.colorscheme.MenuLabel("(empty shows all)"))
+	content := fmt.Sprintf("%s\n%s\n\n\n\n\n [Enter] Filter    [ESC] Cancel", header, label)
+
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(true)
+		ct.Views.Menu.Update(content)
+		ct.Views.Input.Update(value)
+		ct.Views.Input.SetCursor(utf8.RuneCountInString(value), 0)
+		return nil
+	})
+	return nil
+}
+
+// ShowFilterMenu shows the coins filter menu
+func (ct *Cointop) ShowFilterMenu() error {
+	ct.debuglog("showFilterMenu()")
+	ct.State.filterMenuVisible = true
+	ct.SetSelectedView(CoinsView)
+	ct.UpdateFilterMenu()
+	ct.ui.SetCursor(true)
+	ct.SetActiveView(ct.Views.Menu.Name())
+	ct.g.SetViewOnTop(ct.Views.Input.Name())
+	ct.g.SetCurrentView(ct.Views.Input.Name())
+	return nil
+}
+
+// HideFilterMenu hides the coins filter menu
+func (ct *Cointop) HideFilterMenu() error {
+	ct.debuglog("hideFilterMenu()")
+	ct.State.filterMenuVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.ui.SetViewOnBottom(ct.Views.Input)
+	ct.ui.SetCursor(false)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		ct.Views.Menu.Update("")
+		ct.Views.Input.Update("")
+		return nil
+	})
+
+	return nil
+}
+
+// SetFilterFromInput filters the coins with the inputed pattern
+func (ct *Cointop) SetFilterFromInput() error {
+	ct.debuglog("setFilterFromInput()")
+	ct.Views.Input.Rewind()
+	b := make([]byte, 100)
+	n, _ := ct.Views.Input.Read(b)
+	ct.HideFilterMenu()
+	return ct.FilterCoins(string(b[:n]))
+}
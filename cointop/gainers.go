@@ -0,0 +1,74 @@
+package cointop
+
+import (
+	"sort"
+)
+
+// DefaultMoversCount is the default number of coins in the gainers and losers views
+const DefaultMoversCount = 20
+
+// GetMoversSlice returns the coins with the biggest 24 hour percent change, the biggest losses if losers is true
+func (ct *Cointop) GetMoversSlice(losers bool) []*Coin {
+	ct.debuglog("getMoversSlice()")
+	sliced := make([]*Coin, 0, len(ct.State.allCoins))
+	for _, coin := range ct.State.allCoins {
+		if coin != nil {
+			sliced = append(sliced, coin)
+		}
+	}
+	sort.SliceStable(sliced, func(i, j int) bool {
+		if losers {
+			return sliced[i].PercentChange24H < sliced[j].PercentChange24H
+		}
+		return sliced[i].PercentChange24H > sliced[j].PercentChange24H
+	})
+	if len(sliced) > ct.State.moversCount {
+		sliced = sliced[:ct.State.moversCount]
+	}
+
+	return sliced
+}
+
+// ToggleGainers toggles the view of the top 24 hour gainers
+func (ct *Cointop) ToggleGainers() error {
+	ct.debuglog("toggleGainers()")
+	return ct.toggleMoversView(GainersView)
+}
+
+// ToggleLosers toggles the view of the top 24 hour losers
+func (ct *Cointop) ToggleLosers() error {
+	ct.debuglog("toggleLosers()")
+	return ct.toggleMoversView(LosersView)
+}
+
+// toggleMoversView toggles the gainers or losers view. Switching between them goes back to the view before either
+func (ct *Cointop) toggleMoversView(viewName string) error {
+	if ct.IsMoversVisible() && ct.State.selectedView != viewName {
+		// NOTE: keep the view to return to when switching between the gainers and losers
+		last := ct.State.lastSelectedView
+		ct.SetSelectedView(viewName)
+		ct.State.lastSelectedView = last
+	} else {
+		ct.ToggleSelectedView(viewName)
+	}
+	if ct.IsMoversVisible() {
+		ct.NavigateFirstLine()
+	}
+	go ct.UpdateTable()
+	return nil
+}
+
+// IsGainersVisible returns true if the gainers view is visible
+func (ct *Cointop) IsGainersVisible() bool {
+	return ct.State.selectedView == GainersView
+}
+
+// IsLosersVisible returns true if the losers view is visible
+func (ct *Cointop) IsLosersVisible() bool {
+	return ct.State.selectedView == LosersView
+}
+
+// IsMoversVisible returns true if the gainers or losers view is visible
+func (ct *Cointop) IsMoversVisible() bool {
+	return ct.IsGainersVisible() || ct.IsLosersVisible()
+}
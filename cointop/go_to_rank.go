@@ -0,0 +1,97 @@
+package cointop
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// GoToRank navigates to the coin with the rank in the coins table. A rank out of range goes to the first or last coin
+func (ct *Cointop) GoToRank(rank int) error {
+	ct.debuglog("goToRank()")
+	ct.SetSelectedView(CoinsView)
+	total := len(ct.State.allCoins)
+	if total == 0 {
+		return nil
+	}
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > total {
+		rank = total
+	}
+	for i, coin := range ct.State.allCoins {
+		if coin.Rank == rank {
+			return ct.goToCoinIndex(i)
+		}
+	}
+	// NOTE: a rank missing from the loaded coins goes to the row at that position
+	return ct.GoToGlobalIndex(rank - 1)
+}
+
+// UpdateGoToRankMenu updates the go to rank menu view
+func (ct *Cointop) UpdateGoToRankMenu() error {
+	ct.debuglog("updateGoToRankMenu()")
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Go To Rank %s\n\n", pad.Left("[q] close ", ct.width()-12, " ")))
+	label := fmt.Sprintf(" Enter the rank of the coin to go to %s", ct.colorscheme.MenuLabel(fmt.Sprintf("(1-%d)", len(ct.State.allCoins))))
+	content := fmt.Sprintf("%s\n%s\n\n\n\n\n [Enter] Go    [ESC] Cancel", header, label)
+
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(true)
+		ct.Views.Menu.Update(content)
+		ct.Views.Input.Update("")
+		ct.Views.Input.SetCursor(0, 0)
+		return nil
+	})
+	return nil
+}
+
+// ShowGoToRankMenu shows the go to rank menu
+func (ct *Cointop) ShowGoToRankMenu() error {
+	ct.debuglog("showGoToRankMenu()")
+	ct.State.goToRankMenuVisible = true
+	ct.UpdateGoToRankMenu()
+	ct.ui.SetCursor(true)
+	ct.SetActiveView(ct.Views.Menu.Name())
+	ct.g.SetViewOnTop(ct.Views.Input.Name())
+	ct.g.SetCurrentView(ct.Views.Input.Name())
+	return nil
+}
+
+// HideGoToRankMenu hides the go to rank menu
+func (ct *Cointop) HideGoToRankMenu() error {
+	ct.debuglog("hideGoToRankMenu()")
+	ct.State.goToRankMenuVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.ui.SetViewOnBottom(ct.Views.Input)
+	ct.ui.SetCursor(false)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		ct.Views.Menu.Update("")
+		ct.Views.Input.Update("")
+		return nil
+	})
+
+	return nil
+}
+
+// GoToRankFromInput navigates to the coin with the inputed rank. Invalid input is shown in the statusbar
+func (ct *Cointop) GoToRankFromInput() error {
+	ct.debuglog("goToRankFromInput()")
+	ct.Views.Input.Rewind()
+	b := make([]byte, 100)
+	n, _ := ct.Views.Input.Read(b)
+	value := strings.TrimSpace(string(b[:n]))
+	ct.HideGoToRankMenu()
+	if value == "" {
+		return nil
+	}
+	rank, err := strconv.Atoi(value)
+	if err != nil {
+		return ct.UpdateStatusbar(fmt.Sprintf("Invalid rank %q", value))
+	}
+	return ct.GoToRank(rank)
+}
@@ -10,13 +10,18 @@ import (
 // UpdateHelp updates the help views
 func (ct *Cointop) UpdateHelp() {
 	ct.debuglog("updateHelp()")
-	keys := make([]string, 0, len(ct.State.shortcutKeys))
-	for k := range ct.State.shortcutKeys {
+	ct.updateHelpMenu("Help", "List of keyboard shortcuts", ct.State.shortcutKeys)
+}
+
+// updateHelpMenu renders the given shortcuts in the help view
+func (ct *Cointop) updateHelpMenu(title string, instructionsLine string, shortcuts map[string]string) {
+	keys := make([]string, 0, len(shortcuts))
+	for k := range shortcuts {
 		keys = append(keys, k)
 	}
 	sort.Strings(keys)
 
-	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Help %s\n\n", pad.Left("[q] close ", ct.width()-9, " ")))
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" %s %s\n\n", title, pad.Left("[q] close ", ct.width()-len(title)-5, " ")))
 	cnt := 0
 	h := ct.Views.Menu.Height()
 	percol := h - 11
@@ -25,7 +30,7 @@ func (ct *Cointop) UpdateHelp() {
 		cols[i] = make([]string, 20)
 	}
 	for _, k := range keys {
-		v := ct.State.shortcutKeys[k]
+		v := shortcuts[k]
 		if cnt%percol == 0 {
 			cnt = 0
 		}
@@ -46,7 +51,6 @@ func (ct *Cointop) UpdateHelp() {
 
 	versionLine := fmt.Sprintf("cointop %s - (C) 2017-2021 Miguel Mota", ct.Version())
 	licenseLine := "Released under the Apache 2.0 License."
-	instructionsLine := "List of keyboard shortcuts"
 	infoLine := "See git.io/cointop for more info.\n Press ESC to return."
 	content := fmt.Sprintf("%s %s\n %s\n\n %s\n\n%s\n %s", header, versionLine, licenseLine, instructionsLine, body, infoLine)
 
@@ -69,6 +73,7 @@ func (ct *Cointop) ShowHelp() error {
 func (ct *Cointop) HideHelp() error {
 	ct.debuglog("hideHelp()")
 	ct.State.helpVisible = false
+	ct.State.contextHelpVisible = false
 	ct.ui.SetViewOnBottom(ct.Views.Menu)
 	ct.SetActiveView(ct.Views.Table.Name())
 	ct.UpdateUI(func() error {
@@ -0,0 +1,73 @@
+package cointop
+
+// HomeActionPage goes to the first page
+const HomeActionPage = "page"
+
+// HomeActionView switches to the default view
+const HomeActionView = "view"
+
+// HomeActionCursor moves the cursor to the first row
+const HomeActionCursor = "cursor"
+
+// HomeActionSort resets the sort of the view
+const HomeActionSort = "sort"
+
+// HomeActions are the steps the home action can include
+var HomeActions = []string{HomeActionPage, HomeActionView, HomeActionCursor, HomeActionSort}
+
+// DefaultHomeActions are the steps the home action includes by default
+var DefaultHomeActions = []string{HomeActionPage, HomeActionView, HomeActionCursor}
+
+// homeActionIncludes returns true if the home action includes the step
+func (ct *Cointop) homeActionIncludes(step string) bool {
+	for _, v := range ct.State.homeActions {
+		if v == step {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultViewName returns the name of the view set as the default view
+func (ct *Cointop) DefaultViewName() string {
+	switch ct.State.defaultView {
+	case "portfolio":
+		return PortfolioView
+	case "favorites":
+		return FavoritesView
+	case "alerts", "price_alerts":
+		return PriceAlertsView
+	default:
+		return CoinsView
+	}
+}
+
+// GoHome resets the table to the starting state, going to the default view, the first page and the first row
+func (ct *Cointop) GoHome() error {
+	ct.debuglog("goHome()")
+	if ct.homeActionIncludes(HomeActionView) {
+		if view := ct.DefaultViewName(); view != sortViewName(ct.State.selectedView) {
+			ct.SetSelectedView(view)
+			go ct.UpdateChart()
+		}
+	}
+	if ct.homeActionIncludes(HomeActionSort) {
+		viewName := sortViewName(ct.State.selectedView)
+		delete(ct.State.viewSorts, viewName)
+		ct.State.sortBy = "rank"
+		ct.State.sortDesc = false
+		if viewSort, ok := DefaultViewSorts()[viewName]; ok {
+			ct.State.sortBy = viewSort.SortBy
+			ct.State.sortDesc = viewSort.SortDesc
+		}
+	}
+	if ct.homeActionIncludes(HomeActionPage) {
+		ct.State.page = 0
+	}
+	ct.UpdateTable()
+	if ct.homeActionIncludes(HomeActionCursor) {
+		ct.NavigateFirstLine()
+	}
+	ct.RowChanged()
+	return nil
+}
@@ -0,0 +1,70 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/humanize"
+	"github.com/miguelmota/cointop/pkg/pad"
+	"github.com/miguelmota/cointop/pkg/ui"
+)
+
+// InfobarView is structure for the info bar view
+type InfobarView = ui.View
+
+// NewInfobarView returns a new info bar view
+func NewInfobarView() *InfobarView {
+	var view *InfobarView = ui.NewView("infobar")
+	return view
+}
+
+// InfobarHeight returns the height of the info bar, zero if it's hidden
+func (ct *Cointop) InfobarHeight() int {
+	if !ct.State.infobarVisible {
+		return 0
+	}
+	return 1
+}
+
+// ToggleInfobar shows or hides the info bar of the highlighted coin
+func (ct *Cointop) ToggleInfobar() error {
+	ct.debuglog("toggleInfobar()")
+	ct.State.infobarVisible = !ct.State.infobarVisible
+	go ct.UpdateInfobar()
+	return nil
+}
+
+// UpdateInfobar updates the info bar with the stats of the highlighted coin
+func (ct *Cointop) UpdateInfobar() error {
+	ct.debuglog("updateInfobar()")
+	if !ct.State.infobarVisible {
+		return nil
+	}
+
+	var content string
+	if coin := ct.HighlightedRowCoin(); coin != nil {
+		symbol := ct.CurrencySymbol()
+		supply := humanize.Commaf0(coin.AvailableSupply)
+		if percent, ok := CirculatingSupplyPercent(coin); ok {
+			supply = fmt.Sprintf("%s / %s (%s)", supply, humanize.Commaf0(coin.TotalSupply), humanize.Percentf(percent, 2))
+		}
+		stats := []string{
+			fmt.Sprintf("%s (%s) #%d", coin.Name, coin.Symbol, coin.Rank),
+			fmt.Sprintf("Price: %s%s", symbol, humanize.Commaf(coin.Price)),
+			fmt.Sprintf("Market Cap: %s%s", symbol, humanize.Commaf0(coin.MarketCap)),
+			fmt.Sprintf("24H Volume: %s%s", symbol, humanize.Commaf0(coin.Volume24H)),
+			fmt.Sprintf("Supply: %s", supply),
+			fmt.Sprintf("1H: %s 24H: %s 7D: %s", humanize.Percentf(coin.PercentChange1H, 2), humanize.Percentf(coin.PercentChange24H, 2), humanize.Percentf(coin.PercentChange7D, 2)),
+		}
+		content = strings.Join(stats, " • ")
+	}
+
+	content = pad.Right(" "+content, ct.width(), " ")
+	content = ct.colorscheme.Marketbar(content)
+
+	ct.UpdateUI(func() error {
+		return ct.Views.Infobar.Update(content)
+	})
+
+	return nil
+}
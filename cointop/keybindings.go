@@ -198,8 +198,16 @@ func (ct *Cointop) Keybindings(g *gocui.Gui) error {
 			fn = ct.Keyfn(ct.NavigateLastLine)
 		case "open_link":
 			fn = ct.Keyfn(ct.OpenLink)
+		case "toggle_mark":
+			fn = ct.Keyfn(ct.ToggleMark)
+		case "open_marked_links":
+			fn = ct.Keyfn(ct.OpenMarkedLinks)
 		case "refresh":
-			fn = ct.Keyfn(ct.Refresh)
+			fn = ct.Keyfn(ct.ManualRefresh)
+		case "full_refresh":
+			fn = ct.Keyfn(ct.FullRefresh)
+		case "toggle_pause_refresh":
+			fn = ct.Keyfn(ct.TogglePauseRefresh)
 		case "sort_column_asc":
 			fn = ct.Keyfn(ct.SortAsc)
 		case "sort_column_desc":
@@ -211,6 +219,9 @@ func (ct *Cointop) Keybindings(g *gocui.Gui) error {
 		case "help", "toggle_show_help":
 			fn = ct.Keyfn(ct.ToggleHelp)
 			view = ""
+		case "context_help":
+			fn = ct.Keyfn(ct.ToggleContextHelp)
+			view = ""
 		case "show_help":
 			fn = ct.Keyfn(ct.ShowHelp)
 			view = ""
@@ -268,6 +279,74 @@ func (ct *Cointop) Keybindings(g *gocui.Gui) error {
 			view = ""
 		case "toggle_price_alerts":
 			fn = ct.Keyfn(ct.TogglePriceAlerts)
+		case "toggle_gainers":
+			fn = ct.Keyfn(ct.ToggleGainers)
+		case "toggle_losers":
+			fn = ct.Keyfn(ct.ToggleLosers)
+		case "toggle_recently_added":
+			fn = ct.Keyfn(ct.ToggleRecentlyAdded)
+		case "export_table_to_markdown":
+			fn = ct.Keyfn(ct.ExportTableToMarkdown)
+		case "copy_link":
+			fn = ct.Keyfn(ct.CopyRowLink)
+		case "show_per_page_menu":
+			fn = ct.Keyfn(ct.ShowPerPageMenu)
+		case "show_go_to_rank_menu":
+			fn = ct.Keyfn(ct.ShowGoToRankMenu)
+		case "show_favorite_group_menu":
+			fn = ct.Keyfn(ct.ShowFavoriteGroupMenu)
+		case "show_filter_menu":
+			fn = ct.Keyfn(ct.ShowFilterMenu)
+		case "show_market_cap_menu":
+			fn = ct.Keyfn(ct.ShowMarketCapMenu)
+		case "show_column_menu":
+			fn = ct.Keyfn(ct.ShowColumnMenu)
+		case "toggle_chart_pin":
+			fn = ct.Keyfn(ct.ToggleChartPin)
+		case "toggle_chart_volume":
+			fn = ct.Keyfn(ct.ToggleChartVolume)
+		case "export_chart_csv":
+			fn = ct.Keyfn(ct.ExportChartCSV)
+		case "toggle_rank_column":
+			fn = ct.Keyfn(ct.ToggleRankColumn)
+		case "cycle_change_window":
+			fn = ct.Keyfn(ct.CycleChangeWindow)
+		case "show_coin_debug":
+			fn = ct.Keyfn(ct.ShowCoinDebug)
+		case "show_coin_value":
+			fn = ct.Keyfn(ct.ShowCoinValue)
+		case "blacklist_coin":
+			fn = ct.Keyfn(ct.BlacklistCoin)
+		case "toggle_base_currency":
+			fn = ct.Keyfn(ct.ToggleBaseCurrency)
+		case "toggle_infobar":
+			fn = ct.Keyfn(ct.ToggleInfobar)
+		case "toggle_ticker":
+			fn = ct.Keyfn(ct.ToggleTicker)
+		case "toggle_favorites_summary":
+			fn = ct.Keyfn(ct.ToggleFavoritesSummary)
+		case "go_home":
+			fn = ct.Keyfn(ct.GoHome)
+		case "add_to_portfolio":
+			fn = ct.Keyfn(ct.QuickAddPortfolioEntry)
+		case "undo_removal":
+			fn = ct.Keyfn(ct.UndoRemoval)
+		case "toggle_volume_units":
+			fn = ct.Keyfn(ct.ToggleVolumeUnits)
+		case "toggle_privacy_mode":
+			fn = ct.Keyfn(ct.TogglePrivacyMode)
+		case "toggle_chart_sma":
+			fn = ct.Keyfn(ct.ToggleChartSMA)
+		case "toggle_chart_candles":
+			fn = ct.Keyfn(ct.ToggleChartCandles)
+		case "toggle_chart_log_scale":
+			fn = ct.Keyfn(ct.ToggleChartLogScale)
+		case "toggle_chart_compare":
+			fn = ct.Keyfn(ct.ToggleChartCompare)
+		case "move_to_top_gainer":
+			fn = ct.Keyfn(ct.GoToTopGainer)
+		case "move_to_top_loser":
+			fn = ct.Keyfn(ct.GoToTopLoser)
 		case "toggle_favorite":
 			fn = ct.Keyfn(ct.ToggleFavorite)
 		case "toggle_favorites":
@@ -330,20 +409,53 @@ func (ct *Cointop) Keybindings(g *gocui.Gui) error {
 
 	// searchfield keys
 	ct.SetKeybindingMod(gocui.KeyEnter, gocui.ModNone, ct.Keyfn(ct.DoSearch), ct.Views.SearchField.Name())
+	ct.SetKeybindingMod(gocui.KeyEnter, gocui.ModAlt, ct.Keyfn(ct.DoSearchAndFavorite), ct.Views.SearchField.Name())
 	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.CancelSearch), ct.Views.SearchField.Name())
+	ct.SetKeybindingMod(gocui.KeyArrowUp, gocui.ModNone, ct.Keyfn(ct.PreviousSearch), ct.Views.SearchField.Name())
+	ct.SetKeybindingMod(gocui.KeyArrowDown, gocui.ModNone, ct.Keyfn(ct.NextSearch), ct.Views.SearchField.Name())
 
 	// keys to quit help when open
 	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideHelp), ct.Views.Menu.Name())
 	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideHelp), ct.Views.Menu.Name())
 
+	// keys to quit and scroll coin debug view when open
+	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideCoinDebug), ct.Views.Menu.Name())
+	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideCoinDebug), ct.Views.Menu.Name())
+	ct.SetKeybindingMod(gocui.KeyArrowDown, gocui.ModNone, ct.Keyfn(ct.ScrollCoinDebugFn(1)), ct.Views.Menu.Name())
+	ct.SetKeybindingMod(gocui.KeyArrowUp, gocui.ModNone, ct.Keyfn(ct.ScrollCoinDebugFn(-1)), ct.Views.Menu.Name())
+	ct.SetKeybindingMod(gocui.KeyPgdn, gocui.ModNone, ct.Keyfn(ct.ScrollCoinDebugFn(10)), ct.Views.Menu.Name())
+	ct.SetKeybindingMod(gocui.KeyPgup, gocui.ModNone, ct.Keyfn(ct.ScrollCoinDebugFn(-10)), ct.Views.Menu.Name())
+	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideCoinValue), ct.Views.Menu.Name())
+	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideCoinValue), ct.Views.Menu.Name())
+
 	// keys to quit portfolio update menu when open
-	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HidePortfolioUpdateMenu), ct.Views.Input.Name())
-	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HidePortfolioUpdateMenu), ct.Views.Input.Name())
+	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.EscKeyPressHandler), ct.Views.Input.Name())
+	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.EscKeyPressHandler), ct.Views.Input.Name())
 
 	// keys to quit convert menu when open
 	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideConvertMenu), ct.Views.Menu.Name())
 	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideConvertMenu), ct.Views.Menu.Name())
 
+	// keys to choose between coins sharing the searched symbol
+	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideSearchChoiceMenu), ct.Views.Menu.Name())
+	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideSearchChoiceMenu), ct.Views.Menu.Name())
+	for i := 0; i < MaxSearchChoices; i++ {
+		ct.SetKeybindingMod(rune('1'+i), gocui.ModNone, ct.Keyfn(ct.SelectSearchChoiceFn(i)), ct.Views.Menu.Name())
+	}
+
+	// keys to show or hide the coins table columns
+	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideColumnMenu), ct.Views.Menu.Name())
+	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideColumnMenu), ct.Views.Menu.Name())
+	for i := range SupportedCoinTableHeaders {
+		ct.SetKeybindingMod(columnMenuKeys[i], gocui.ModNone, ct.Keyfn(ct.ToggleColumnFn(i)), ct.Views.Menu.Name())
+	}
+
+	// keys to confirm or cancel removing a favorite or portfolio entry
+	ct.SetKeybindingMod('y', gocui.ModNone, ct.Keyfn(ct.Confirm), ct.Views.Menu.Name())
+	ct.SetKeybindingMod('n', gocui.ModNone, ct.Keyfn(ct.HideConfirmMenu), ct.Views.Menu.Name())
+	ct.SetKeybindingMod(gocui.KeyEsc, gocui.ModNone, ct.Keyfn(ct.HideConfirmMenu), ct.Views.Menu.Name())
+	ct.SetKeybindingMod('q', gocui.ModNone, ct.Keyfn(ct.HideConfirmMenu), ct.Views.Menu.Name())
+
 	// keys to update portfolio holdings
 	ct.SetKeybindingMod(gocui.KeyEnter, gocui.ModNone, ct.Keyfn(ct.EnterKeyPressHandler), ct.Views.Input.Name())
 
@@ -3,12 +3,23 @@ package cointop
 import (
 	"fmt"
 	"strings"
+
+	"github.com/miguelmota/cointop/pkg/ui"
 )
 
 // TODO: break up into small functions
 
 var lastWidth int
 
+// TooSmallView is structure for the terminal too small view
+type TooSmallView = ui.View
+
+// NewTooSmallView returns a new terminal too small view
+func NewTooSmallView() *TooSmallView {
+	var view *TooSmallView = ui.NewView("too_small")
+	return view
+}
+
 // layout sets initial layout
 func (ct *Cointop) layout() error {
 	ct.debuglog("layout()")
@@ -19,6 +30,8 @@ func (ct *Cointop) layout() error {
 	headerHeight := 1
 	marketbarHeight := ct.State.marketBarHeight
 	chartHeight := ct.State.chartHeight
+	infobarHeight := ct.InfobarHeight()
+	tickerHeight := ct.TickerHeight()
 	statusbarHeight := 1
 
 	if ct.State.onlyTable {
@@ -40,6 +53,41 @@ func (ct *Cointop) layout() error {
 		statusbarHeight = 0
 	}
 
+	if ct.State.chartHeightAuto && !ct.State.hideChart {
+		chartHeight = ct.autoChartHeight(maxY, maxY-tickerHeight-marketbarHeight-infobarHeight-headerHeight-statusbarHeight-ct.State.minTableRows)
+	}
+
+	minWidth := ct.State.minLayoutWidth
+	minHeight := tickerHeight + marketbarHeight + chartHeight + infobarHeight + headerHeight + statusbarHeight + ct.State.minTableRows
+	if maxX < minWidth || maxY < minHeight {
+		return ct.layoutTooSmall(maxX, maxY, minWidth, minHeight)
+	}
+
+	if ct.Views.TooSmall.Backing() != nil {
+		if err := ct.g.DeleteView(ct.Views.TooSmall.Name()); err != nil {
+			return err
+		}
+		ct.Views.TooSmall.SetBacking(nil)
+	}
+
+	if tickerHeight == 0 {
+		if ct.Views.Ticker.Backing() != nil {
+			if err := ct.g.DeleteView(ct.Views.Ticker.Name()); err != nil {
+				return err
+			}
+			ct.Views.Ticker.SetBacking(nil)
+		}
+	} else {
+		if err := ct.ui.SetView(ct.Views.Ticker, 0, topOffset-1, maxX, topOffset+tickerHeight); err != nil {
+			ct.Views.Ticker.SetFrame(false)
+			ct.Views.Ticker.SetFgColor(ct.colorscheme.gocuiFgColor(ct.Views.Marketbar.Name()))
+			ct.Views.Ticker.SetBgColor(ct.colorscheme.gocuiBgColor(ct.Views.Marketbar.Name()))
+			go ct.UpdateTicker()
+		}
+	}
+
+	topOffset = topOffset + tickerHeight
+
 	if ct.State.hideMarketbar {
 		if ct.Views.Marketbar.Backing() != nil {
 			if err := ct.g.DeleteView(ct.Views.Marketbar.Name()); err != nil {
@@ -48,7 +96,7 @@ func (ct *Cointop) layout() error {
 			ct.Views.Marketbar.SetBacking(nil)
 		}
 	} else {
-		if err := ct.ui.SetView(ct.Views.Marketbar, 0, topOffset-1, maxX, marketbarHeight+1); err != nil {
+		if err := ct.ui.SetView(ct.Views.Marketbar, 0, topOffset-1, maxX, topOffset+marketbarHeight+1); err != nil {
 			ct.Views.Marketbar.SetFrame(false)
 			ct.Views.Marketbar.SetFgColor(ct.colorscheme.gocuiFgColor(ct.Views.Marketbar.Name()))
 			ct.Views.Marketbar.SetBgColor(ct.colorscheme.gocuiBgColor(ct.Views.Marketbar.Name()))
@@ -90,8 +138,26 @@ func (ct *Cointop) layout() error {
 		}
 	}
 
-	tableOffsetX := ct.State.tableOffsetX
 	topOffset = topOffset + chartHeight
+
+	if infobarHeight == 0 {
+		if ct.Views.Infobar.Backing() != nil {
+			if err := ct.g.DeleteView(ct.Views.Infobar.Name()); err != nil {
+				return err
+			}
+			ct.Views.Infobar.SetBacking(nil)
+		}
+	} else {
+		if err := ct.ui.SetView(ct.Views.Infobar, 0, topOffset-1, maxX, topOffset+infobarHeight); err != nil {
+			ct.Views.Infobar.SetFrame(false)
+			ct.Views.Infobar.SetFgColor(ct.colorscheme.gocuiFgColor(ct.Views.Marketbar.Name()))
+			ct.Views.Infobar.SetBgColor(ct.colorscheme.gocuiBgColor(ct.Views.Marketbar.Name()))
+			go ct.UpdateInfobar()
+		}
+	}
+
+	tableOffsetX := ct.State.tableOffsetX
+	topOffset = topOffset + infobarHeight
 	if err := ct.ui.SetView(ct.Views.TableHeader, tableOffsetX, topOffset-1, maxX, topOffset+1); err != nil {
 		ct.Views.TableHeader.SetFrame(false)
 		ct.Views.TableHeader.SetFgColor(ct.colorscheme.gocuiFgColor(ct.Views.TableHeader.Name()))
@@ -110,8 +176,11 @@ func (ct *Cointop) layout() error {
 			ct.cache.Delete("allCoinsSlugMap")
 		}
 		go func() {
-			ct.UpdateCoins()
+			if !ct.IsOffline() {
+				ct.UpdateCoins()
+			}
 			ct.UpdateTable()
+			ct.restoreTablePosition()
 		}()
 	}
 
@@ -168,3 +237,20 @@ func (ct *Cointop) layout() error {
 
 	return nil
 }
+
+// layoutTooSmall shows a single message in place of the full layout when the terminal is below the minimum size
+func (ct *Cointop) layoutTooSmall(maxX, maxY, minWidth, minHeight int) error {
+	ct.debuglog("layoutTooSmall()")
+	if err := ct.ui.SetView(ct.Views.TooSmall, -1, -1, maxX, maxY); err != nil {
+		ct.Views.TooSmall.SetFrame(false)
+		ct.Views.TooSmall.SetWrap(true)
+		ct.Views.TooSmall.SetFgColor(ct.colorscheme.BaseFg())
+		ct.Views.TooSmall.SetBgColor(ct.colorscheme.BaseBg())
+	}
+	if err := ct.ui.SetViewOnTop(ct.Views.TooSmall); err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("terminal too small (%dx%d)\nresize to at least %dx%d", maxX, maxY, minWidth, minHeight)
+	return ct.Views.TooSmall.Update(msg)
+}
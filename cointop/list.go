@@ -1,6 +1,10 @@
 package cointop
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -29,15 +33,25 @@ func (ct *Cointop) UpdateCoins() error {
 	// cache miss
 	if allCoinsSlugMap == nil {
 		ct.debuglog("cache miss")
-		ch := make(chan []types.Coin)
-		err = ct.api.GetAllCoinData(ct.State.currencyConversion, ch)
+		if ct.IsOffline() {
+			return ErrOffline
+		}
+		ct.nextRankGeneration()
+		var received bool
+		received, err = ct.fetchAllCoins()
+		if (err != nil || !received) && ct.failoverAPI() {
+			// NOTE: the call is retried once with the fallback API
+			received, err = ct.fetchAllCoins()
+		}
 		if err != nil {
 			return err
 		}
-
-		for coins := range ch {
-			go ct.processCoins(coins)
+		ct.handleAPIResult(received)
+		if !received {
+			ct.checkOffline()
+			return nil
 		}
+		go ct.updatePriceCurrencyRates()
 	} else {
 		ct.processCoinsMap(allCoinsSlugMap)
 	}
@@ -45,6 +59,116 @@ func (ct *Cointop) UpdateCoins() error {
 	return nil
 }
 
+// fetchAllCoins fetches and processes the coins of the active API. It returns true if any coins were received
+func (ct *Cointop) fetchAllCoins() (bool, error) {
+	ch := make(chan []types.Coin)
+	if err := ct.API().GetAllCoinData(ct.State.currencyConversion, ch); err != nil {
+		return false, err
+	}
+
+	var received bool
+	for coins := range ch {
+		received = received || len(coins) > 0
+		go ct.processCoins(coins)
+	}
+	return received, nil
+}
+
+// PrintCoinsJSON fetches the coins using the configured API and outputs them as a JSON array ordered by rank.
+// A limit of 0 outputs all the fetched coins and an empty convert value uses the configured currency
+func (ct *Cointop) PrintCoinsJSON(limit int, convert string) error {
+	ct.debuglog("printCoinsJSON()")
+	if limit < 0 {
+		return fmt.Errorf("the limit %d must not be negative", limit)
+	}
+	if ct.IsOffline() {
+		return ErrOffline
+	}
+	if convert == "" {
+		convert = ct.State.currencyConversion
+	}
+	convert = strings.ToUpper(convert)
+	if !ct.IsSupportedCurrencyConversion(convert) {
+		return fmt.Errorf("the currency %q is not supported", convert)
+	}
+
+	ch := make(chan []types.Coin)
+	if err := ct.API().GetAllCoinData(convert, ch); err != nil {
+		return err
+	}
+
+	list := []*Coin{}
+	for coins := range ch {
+		for _, v := range coins {
+			if ct.IsBlacklisted(v.Name, v.ID) {
+				continue
+			}
+			// some APIs returns rank 0 for new coins
+			if v.Rank == 0 {
+				v.Rank = UnrankedCoinRank
+			}
+			coin := coinFromAPI(v)
+			coin.Favorite = ct.State.favorites[v.Name]
+			list = append(list, coin)
+		}
+		// NOTE: the pages are ordered by market cap so the remaining pages aren't needed. They're drained in the
+		// background so the API goroutine sending them isn't left blocked
+		if limit > 0 && len(list) >= limit {
+			go func() {
+				for range ch {
+				}
+			}()
+			break
+		}
+	}
+	if len(list) == 0 {
+		return fmt.Errorf("failed to get the coins")
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		return list[i].Rank < list[j].Rank
+	})
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+
+	output, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(output))
+	return nil
+}
+
+// coinFromAPI returns the coin of the API coin data
+func coinFromAPI(v types.Coin) *Coin {
+	return &Coin{
+		ID:               v.ID,
+		Name:             v.Name,
+		Symbol:           v.Symbol,
+		Rank:             v.Rank,
+		Price:            v.Price,
+		Volume24H:        v.Volume24H,
+		MarketCap:        v.MarketCap,
+		FDV:              v.FDV,
+		High24H:          v.High24H,
+		Low24H:           v.Low24H,
+		ATH:              v.ATH,
+		PercentFromATH:   v.PercentFromATH,
+		AvailableSupply:  v.AvailableSupply,
+		TotalSupply:      v.TotalSupply,
+		MaxSupply:        v.MaxSupply,
+		PercentChange1H:  v.PercentChange1H,
+		PercentChange24H: v.PercentChange24H,
+		PercentChange7D:  v.PercentChange7D,
+		PercentChange30D: v.PercentChange30D,
+		LastUpdated:      v.LastUpdated,
+		DateAdded:        v.DateAdded,
+		Sparkline7D:      v.Sparkline7D,
+	}
+}
+
 // ProcessCoinsMap processes coins map
 func (ct *Cointop) processCoinsMap(coinsMap map[string]types.Coin) {
 	ct.debuglog("processCoinsMap()")
@@ -67,43 +191,38 @@ func (ct *Cointop) processCoins(coins []types.Coin) {
 
 	for _, v := range coins {
 		k := v.Name
+		if ct.IsBlacklisted(v.Name, v.ID) {
+			continue
+		}
 
 		// Fix for https://github.com/miguelmota/cointop/issues/59
 		// some APIs returns rank 0 for new coins
 		// or coins with low market cap data so we need to put them
 		// at the end of the list
 		if v.Rank == 0 {
-			v.Rank = 10000
+			v.Rank = UnrankedCoinRank
 		}
 
 		ilast, _ := ct.State.allCoinsSlugMap.Load(k)
-		ct.State.allCoinsSlugMap.Store(k, &Coin{
-			ID:               v.ID,
-			Name:             v.Name,
-			Symbol:           v.Symbol,
-			Rank:             v.Rank,
-			Price:            v.Price,
-			Volume24H:        v.Volume24H,
-			MarketCap:        v.MarketCap,
-			AvailableSupply:  v.AvailableSupply,
-			TotalSupply:      v.TotalSupply,
-			PercentChange1H:  v.PercentChange1H,
-			PercentChange24H: v.PercentChange24H,
-			PercentChange7D:  v.PercentChange7D,
-			PercentChange30D: v.PercentChange30D,
-			LastUpdated:      v.LastUpdated,
-		})
+		ct.State.allCoinsSlugMap.Store(k, coinFromAPI(v))
 		if ilast != nil {
 			last, _ := ilast.(*Coin)
 			if last != nil {
 				ivalue, _ := ct.State.allCoinsSlugMap.Load(k)
 				l, _ := ivalue.(*Coin)
 				l.Favorite = last.Favorite
+				l.PrevPrice = last.Price
+				if l.DateAdded == "" {
+					l.DateAdded = last.DateAdded
+				}
 				ct.State.allCoinsSlugMap.Store(k, l)
 			}
 		}
 	}
 
+	ct.recordRanks(coins)
+	ct.recordRefreshRanks(coins)
+
 	size := 0
 	// NOTE: there's no Len method on sync.Map so need to manually count
 	ct.State.allCoinsSlugMap.Range(func(key, value interface{}) bool {
@@ -115,7 +234,10 @@ func (ct *Cointop) processCoins(coins []types.Coin) {
 		list := []*Coin{}
 		for _, v := range coins {
 			k := v.Name
-			icoin, _ := ct.State.allCoinsSlugMap.Load(k)
+			icoin, ok := ct.State.allCoinsSlugMap.Load(k)
+			if !ok {
+				continue
+			}
 			coin, _ := icoin.(*Coin)
 			list = append(list, coin)
 		}
@@ -133,15 +255,24 @@ func (ct *Cointop) processCoins(coins []types.Coin) {
 					c.Symbol = cm.Symbol
 					c.Rank = cm.Rank
 					c.Price = cm.Price
+					c.PrevPrice = cm.PrevPrice
 					c.Volume24H = cm.Volume24H
 					c.MarketCap = cm.MarketCap
+					c.FDV = cm.FDV
+					c.High24H = cm.High24H
+					c.Low24H = cm.Low24H
+					c.ATH = cm.ATH
+					c.PercentFromATH = cm.PercentFromATH
 					c.AvailableSupply = cm.AvailableSupply
 					c.TotalSupply = cm.TotalSupply
+					c.MaxSupply = cm.MaxSupply
 					c.PercentChange1H = cm.PercentChange1H
 					c.PercentChange24H = cm.PercentChange24H
 					c.PercentChange7D = cm.PercentChange7D
 					c.PercentChange30D = cm.PercentChange30D
 					c.LastUpdated = cm.LastUpdated
+					c.DateAdded = cm.DateAdded
+					c.Sparkline7D = cm.Sparkline7D
 					c.Favorite = cm.Favorite
 				}
 			}
@@ -163,6 +294,12 @@ func (ct *Cointop) GetListCount() int {
 		return len(ct.State.favorites)
 	} else if ct.IsPortfolioVisible() {
 		return len(ct.State.portfolio.Entries)
+	} else if ct.IsRecentlyAddedVisible() {
+		return len(ct.State.recentlyAdded)
+	} else if ct.IsMoversVisible() {
+		return len(ct.AllCoins())
+	} else if ct.IsCoinsFiltered() {
+		return len(ct.AllCoins())
 	} else {
 		return len(ct.State.allCoins)
 	}
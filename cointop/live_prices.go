@@ -0,0 +1,160 @@
+package cointop
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/api/vendors/binance"
+)
+
+// LivePricesQuote is the currency the live prices are quoted in
+const LivePricesQuote = "USDT"
+
+// livePricesCheckInterval is how often the favorites are checked to open, reopen or close the live prices stream
+var livePricesCheckInterval = 10 * time.Second
+
+// livePricesRedrawInterval is the min time between table redraws caused by live prices
+var livePricesRedrawInterval = time.Second
+
+// LivePricesWatcher streams the prices of the favorites from Binance and updates their rows between refreshes.
+// The prices are only streamed when converting to USD or USDT, and the refresh rate keeps updating the prices
+// whenever the stream isn't available
+func (ct *Cointop) LivePricesWatcher() {
+	ct.debuglog("livePricesWatcher()")
+	ticker := time.NewTicker(livePricesCheckInterval)
+	defer ticker.Stop()
+	for {
+		if pairCoins := ct.livePriceCoins(); len(pairCoins) > 0 {
+			ct.streamLivePrices(pairCoins)
+		}
+		<-ticker.C
+	}
+}
+
+// livePriceCoins returns the names of the favorites by their Binance pair, e.g. "BTCUSDT", or nil if live prices
+// can't be shown. A pair is resolved to the best ranked favorite when several favorites share the symbol, so a
+// coin is never updated with the price of another coin of the same symbol
+func (ct *Cointop) livePriceCoins() map[string]string {
+	if !ct.State.livePrices || ct.IsOffline() || ct.IsDemoMode() {
+		return nil
+	}
+	switch ct.State.currencyConversion {
+	case "USD", LivePricesQuote:
+	default:
+		return nil
+	}
+
+	updatecoinsmux.Lock()
+	defer updatecoinsmux.Unlock()
+	pairCoins := make(map[string]string)
+	ranks := make(map[string]int)
+	for _, coin := range ct.State.allCoins {
+		if !coin.Favorite || coin.Symbol == LivePricesQuote {
+			continue
+		}
+		pair := strings.ToUpper(coin.Symbol) + LivePricesQuote
+		if rank, ok := ranks[pair]; ok && rank <= coin.Rank {
+			continue
+		}
+		pairCoins[pair] = coin.Name
+		ranks[pair] = coin.Rank
+	}
+	return pairCoins
+}
+
+// livePricePairs returns the sorted pairs to stream of the pair coins
+func livePricePairs(pairCoins map[string]string) []string {
+	var pairs []string
+	for pair := range pairCoins {
+		pairs = append(pairs, strings.ToLower(pair))
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// streamLivePrices updates the prices of the favorites from the stream of their pairs. It returns when the stream
+// fails or the pairs to stream change
+func (ct *Cointop) streamLivePrices(pairCoins map[string]string) {
+	ct.debuglog("streamLivePrices()")
+	stream, err := binance.NewMiniTickerStream(livePricePairs(pairCoins))
+	if err != nil {
+		ct.debuglog(fmt.Sprintf("streamLivePrices() %s", err))
+		return
+	}
+	defer stream.Close()
+
+	// NOTE: closing the stream unblocks the read below so a new stream can be opened
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(livePricesCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if !reflect.DeepEqual(ct.livePriceCoins(), pairCoins) {
+					stream.Close()
+					return
+				}
+			}
+		}
+	}()
+
+	var lastRedraw time.Time
+	for {
+		miniTicker, err := stream.Next()
+		if err != nil {
+			ct.debuglog(fmt.Sprintf("streamLivePrices() %s", err))
+			return
+		}
+		// NOTE: the prices are left as of the pause, like the rest of the table
+		if ct.IsRefreshPaused() {
+			continue
+		}
+		price, err := strconv.ParseFloat(miniTicker.Close, 64)
+		if err != nil || price <= 0 {
+			continue
+		}
+		name, ok := pairCoins[strings.ToUpper(miniTicker.Symbol)]
+		if !ok || !ct.setLivePrice(name, price) {
+			continue
+		}
+		if time.Since(lastRedraw) >= livePricesRedrawInterval {
+			lastRedraw = time.Now()
+			go ct.UpdateTable()
+		}
+	}
+}
+
+// setLivePrice sets the price of the favorite with the name. It returns true if the price changed
+func (ct *Cointop) setLivePrice(name string, price float64) bool {
+	// NOTE: the coins are updated under the same lock as the refreshes so a refresh never races with the stream
+	updatecoinsmux.Lock()
+	defer updatecoinsmux.Unlock()
+	changed := false
+	for _, coin := range ct.State.allCoins {
+		if !coin.Favorite || coin.Name != name || coin.Price == price {
+			continue
+		}
+		coin.PrevPrice = coin.Price
+		coin.Price = price
+		changed = true
+	}
+	return changed
+}
+
+// loadLivePricesFromConfig loads the live prices setting from config file to struct
+func (ct *Cointop) loadLivePricesFromConfig() error {
+	ct.debuglog("loadLivePricesFromConfig()")
+	if livePrices, ok := ct.config.LivePrices.(bool); ok {
+		ct.State.livePrices = livePrices
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package cointop
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestLivePriceCoins checks that a pair is resolved to the best ranked favorite with the symbol
+func TestLivePriceCoins(t *testing.T) {
+	ct := &Cointop{State: &State{
+		livePrices:         true,
+		currencyConversion: "USD",
+		allCoins: []*Coin{
+			{Name: "Uniswap", Symbol: "UNI", Rank: 20, Favorite: true},
+			{Name: "Universe", Symbol: "UNI", Rank: 900, Favorite: true},
+			{Name: "Bitcoin", Symbol: "BTC", Rank: 1, Favorite: true},
+			{Name: "Ethereum", Symbol: "ETH", Rank: 2},
+			{Name: "Tether", Symbol: "USDT", Rank: 3, Favorite: true},
+		},
+	}}
+
+	pairCoins := ct.livePriceCoins()
+	want := map[string]string{"BTCUSDT": "Bitcoin", "UNIUSDT": "Uniswap"}
+	if !reflect.DeepEqual(pairCoins, want) {
+		t.Errorf("pair coins == %v, want %v", pairCoins, want)
+	}
+	if pairs := livePricePairs(pairCoins); !reflect.DeepEqual(pairs, []string{"btcusdt", "uniusdt"}) {
+		t.Errorf("pairs == %v, want [btcusdt uniusdt]", pairs)
+	}
+
+	if !ct.setLivePrice("Uniswap", 7.5) {
+		t.Error("expected the price to change")
+	}
+	if price := ct.State.allCoins[1].Price; price != 0 {
+		t.Errorf("price == %v, want the coin of the same symbol unchanged", price)
+	}
+	if ct.setLivePrice("Uniswap", 7.5) {
+		t.Error("expected the same price not to change")
+	}
+}
@@ -0,0 +1,202 @@
+package cointop
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/miguelmota/cointop/pkg/humanize"
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// marketCapSuffixes are the multipliers of the suffixes accepted in market cap input
+var marketCapSuffixes = map[string]float64{
+	"k": 1e3,
+	"m": 1e6,
+	"b": 1e9,
+	"t": 1e12,
+}
+
+// SetMarketCapRange shows only the coins with a market cap between min and max in the coins table. A zero bound
+// leaves that side of the range open, so zero for both shows all the coins again
+func (ct *Cointop) SetMarketCapRange(min float64, max float64) error {
+	ct.debuglog("setMarketCapRange()")
+	if err := validateMarketCapRange(min, max); err != nil {
+		return err
+	}
+
+	ct.State.marketCapMin = min
+	ct.State.marketCapMax = max
+	ct.State.page = 0
+	ct.UpdateTable()
+	return ct.HighlightRow(0)
+}
+
+// validateMarketCapRange returns an error if the bounds of the range are negative or out of order
+func validateMarketCapRange(min float64, max float64) error {
+	if min < 0 || max < 0 {
+		return errors.New("market cap must not be negative")
+	}
+	if max != 0 && min > max {
+		return errors.New("min market cap must not be greater than max")
+	}
+	return nil
+}
+
+// IsMarketCapFiltered returns true if a market cap range is set
+func (ct *Cointop) IsMarketCapFiltered() bool {
+	return ct.State.marketCapMin > 0 || ct.State.marketCapMax > 0
+}
+
+// inMarketCapRange returns true if the market cap of the coin is within the range
+func (ct *Cointop) inMarketCapRange(coin *Coin) bool {
+	if ct.State.marketCapMin > 0 && coin.MarketCap < ct.State.marketCapMin {
+		return false
+	}
+	if ct.State.marketCapMax > 0 && coin.MarketCap > ct.State.marketCapMax {
+		return false
+	}
+	return true
+}
+
+// ParseMarketCapRange parses a market cap range from input in the form "min-max". Either bound may be left out and
+// may end in k, m, b or t for thousands, millions, billions or trillions
+func ParseMarketCapRange(input string) (float64, float64, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, 0, nil
+	}
+	parts := strings.Split(input, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid range %q. Expected min-max", input)
+	}
+	min, err := parseMarketCap(parts[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err := parseMarketCap(parts[1])
+	if err != nil {
+		return 0, 0, err
+	}
+	return min, max, nil
+}
+
+// parseMarketCap parses a market cap with an optional suffix. An empty value is zero
+func parseMarketCap(input string) (float64, error) {
+	input = strings.ToLower(strings.Replace(strings.TrimSpace(input), ",", "", -1))
+	if input == "" {
+		return 0, nil
+	}
+	multiplier := 1.0
+	if m, ok := marketCapSuffixes[input[len(input)-1:]]; ok {
+		multiplier = m
+		input = input[:len(input)-1]
+	}
+	value, err := strconv.ParseFloat(strings.TrimSpace(input), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid market cap %q", input)
+	}
+	return value * multiplier, nil
+}
+
+// marketCapRangeString returns the market cap range as input
+func (ct *Cointop) marketCapRangeString() string {
+	if !ct.IsMarketCapFiltered() {
+		return ""
+	}
+	var min, max string
+	if ct.State.marketCapMin > 0 {
+		min = strconv.FormatFloat(ct.State.marketCapMin, 'f', -1, 64)
+	}
+	if ct.State.marketCapMax > 0 {
+		max = strconv.FormatFloat(ct.State.marketCapMax, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%s-%s", min, max)
+}
+
+// UpdateMarketCapMenu updates the market cap range menu view
+func (ct *Cointop) UpdateMarketCapMenu(errMsg string) error {
+	ct.debuglog("updateMarketCapMenu()")
+	value := ct.marketCapRangeString()
+	symbol := ct.CurrencySymbol()
+	current := "none"
+	switch {
+	case ct.State.marketCapMax == 0 && ct.State.marketCapMin > 0:
+		current = fmt.Sprintf("over %s%s", symbol, humanize.Commaf0(ct.State.marketCapMin))
+	case ct.State.marketCapMax > 0 && ct.State.marketCapMin == 0:
+		current = fmt.Sprintf("under %s%s", symbol, humanize.Commaf0(ct.State.marketCapMax))
+	case ct.State.marketCapMax > 0:
+		current = fmt.Sprintf("%s%s to %s%s", symbol, humanize.Commaf0(ct.State.marketCapMin), symbol, humanize.Commaf0(ct.State.marketCapMax))
+	}
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Filter By Market Cap %s\n\n", pad.Left("[q] close ", ct.width()-22, " ")))
+	label := fmt.Sprintf(" Enter the market cap range in %s as min-max, e.g. 100m-1b %s", ct.State.currencyConversion, ct.colorscheme.MenuLabel(fmt.Sprintf("(current %s)", current)))
+	var errText string
+	if errMsg != "" {
+		errText = fmt.Sprintf("\n\n %s", errMsg)
+	}
+	content := fmt.Sprintf("%s\n%s\n\n\n\n\n [Enter] Filter    [ESC] Cancel    Empty shows all coins%s", header, label, errText)
+
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(true)
+		ct.Views.Menu.Update(content)
+		if errMsg == "" {
+			ct.Views.Input.Update(value)
+			ct.Views.Input.SetCursor(utf8.RuneCountInString(value), 0)
+		}
+		return nil
+	})
+	return nil
+}
+
+// ShowMarketCapMenu shows the market cap range menu
+func (ct *Cointop) ShowMarketCapMenu() error {
+	ct.debuglog("showMarketCapMenu()")
+	ct.State.marketCapMenuVisible = true
+	ct.SetSelectedView(CoinsView)
+	ct.UpdateMarketCapMenu("")
+	ct.ui.SetCursor(true)
+	ct.SetActiveView(ct.Views.Menu.Name())
+	ct.g.SetViewOnTop(ct.Views.Input.Name())
+	ct.g.SetCurrentView(ct.Views.Input.Name())
+	return nil
+}
+
+// HideMarketCapMenu hides the market cap range menu
+func (ct *Cointop) HideMarketCapMenu() error {
+	ct.debuglog("hideMarketCapMenu()")
+	ct.State.marketCapMenuVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.ui.SetViewOnBottom(ct.Views.Input)
+	ct.ui.SetCursor(false)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		ct.Views.Menu.Update("")
+		ct.Views.Input.Update("")
+		return nil
+	})
+
+	return nil
+}
+
+// SetMarketCapRangeFromInput sets the market cap range from the inputed value
+func (ct *Cointop) SetMarketCapRangeFromInput() error {
+	ct.debuglog("setMarketCapRangeFromInput()")
+
+	// read input field from the start since it may have been read after invalid input
+	ct.Views.Input.Rewind()
+	b := make([]byte, 100)
+	n, _ := ct.Views.Input.Read(b)
+	min, max, err := ParseMarketCapRange(string(b[:n]))
+	if err == nil {
+		err = validateMarketCapRange(min, max)
+	}
+	if err != nil {
+		return ct.UpdateMarketCapMenu(err.Error())
+	}
+
+	ct.HideMarketCapMenu()
+	return ct.SetMarketCapRange(min, max)
+}
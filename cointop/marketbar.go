@@ -36,12 +36,14 @@ func (ct *Cointop) UpdateMarketbar() error {
 		ct.State.marketBarHeight = 1
 		total := ct.GetPortfolioTotal()
 		totalstr := humanize.Commaf(total)
-		if !(ct.State.currencyConversion == "BTC" || ct.State.currencyConversion == "ETH" || total < 1) {
+		currency := ct.PortfolioCurrency()
+		if !(currency == "BTC" || currency == "ETH" || total < 1) {
 			total = math.Round(total*1e2) / 1e2
 			totalstr = humanize.Commaf2(total)
 		}
+		totalstr = ct.MaskValue(totalstr)
 
-		timeframe := ct.State.selectedChartRange
+		timeframe := ct.chartTimeframeLabel()
 		chartname := ct.SelectedCoinName()
 		var charttitle string
 		if chartname == "" {
@@ -51,14 +53,16 @@ func (ct *Cointop) UpdateMarketbar() error {
 			charttitle = fmt.Sprintf("Portfolio - %s", ct.colorscheme.MarketBarLabelActive(chartname))
 		}
 
-		var percentChange24H float64
-		for _, p := range ct.GetPortfolioSlice() {
-			n := ((p.Balance / total) * p.PercentChange24H)
-			if math.IsNaN(n) {
-				continue
-			}
-			percentChange24H += n
+		change24H, percentChange24H := ct.GetPortfolioChange24H()
+		sign := "+"
+		if change24H < 0 {
+			sign = "-"
+		}
+		change24Hstr := humanize.Commaf2(math.Round(math.Abs(change24H)*1e2) / 1e2)
+		if currency == "BTC" || currency == "ETH" {
+			change24Hstr = humanize.Commaf(math.Round(math.Abs(change24H)*1e8) / 1e8)
 		}
+		change24Hstr = ct.MaskValue(fmt.Sprintf("%s%s%s", sign, CurrencySymbol(currency), change24Hstr))
 
 		color24h := ct.colorscheme.MarketbarSprintf()
 		arrow := ""
@@ -70,6 +74,12 @@ func (ct *Cointop) UpdateMarketbar() error {
 			color24h = ct.colorscheme.MarketbarChangeDownSprintf()
 			arrow = "▼"
 		}
+		totalstr = fmt.Sprintf("%s%s", CurrencySymbol(currency), totalstr)
+		change24Hstr = fmt.Sprintf("%s (%s)%s", change24Hstr, humanize.Percentf(percentChange24H, 2), arrow)
+		if ct.IsPortfolioRatePending() {
+			totalstr = PortfolioRatePending
+			change24Hstr = PortfolioRatePending
+		}
 
 		chartInfo := ""
 		if !ct.State.hideChart {
@@ -83,8 +93,8 @@ func (ct *Cointop) UpdateMarketbar() error {
 		content = fmt.Sprintf(
 			"%sTotal Portfolio Value: %s • 24H: %s",
 			chartInfo,
-			ct.colorscheme.MarketBarLabelActive(fmt.Sprintf("%s%s", ct.CurrencySymbol(), totalstr)),
-			color24h(fmt.Sprintf("%.2f%%%s", percentChange24H, arrow)),
+			ct.colorscheme.MarketBarLabelActive(totalstr),
+			color24h(change24Hstr),
 		)
 	} else {
 		ct.State.marketBarHeight = 1
@@ -107,14 +117,17 @@ func (ct *Cointop) UpdateMarketbar() error {
 		}
 
 		if market.TotalMarketCapUSD == 0 {
-			market, err = ct.api.GetGlobalMarketData(ct.State.currencyConversion)
+			err = ErrOffline
+			if !ct.IsOffline() {
+				market, err = ct.API().GetGlobalMarketData(ct.State.currencyConversion)
+			}
 			if err != nil {
 				if ct.filecache != nil {
 					ct.filecache.Get(cachekey, &market)
 				}
 			}
 
-			ct.cache.Set(cachekey, market, 10*time.Second)
+			ct.cache.Set(cachekey, market, ct.marketCacheExpiration())
 			if ct.filecache != nil {
 				go func() {
 					ct.filecache.Set(cachekey, market, 24*time.Hour)
@@ -122,7 +135,7 @@ func (ct *Cointop) UpdateMarketbar() error {
 			}
 		}
 
-		timeframe := ct.State.selectedChartRange
+		timeframe := ct.chartTimeframeLabel()
 		chartname := ct.SelectedCoinName()
 		if chartname == "" {
 			chartname = "Global"
@@ -147,13 +160,13 @@ func (ct *Cointop) UpdateMarketbar() error {
 		}
 
 		content = fmt.Sprintf(
-			"%sGlobal ▶ Market Cap: %s %s 24H Volume: %s %s BTC Dominance: %.2f%%",
+			"%sGlobal ▶ Market Cap: %s %s 24H Volume: %s %s BTC Dominance: %s",
 			chartInfo,
 			fmt.Sprintf("%s%s", ct.CurrencySymbol(), humanize.Commaf0(market.TotalMarketCapUSD)),
 			separator1,
 			fmt.Sprintf("%s%s", ct.CurrencySymbol(), humanize.Commaf0(market.Total24HVolumeUSD)),
 			separator2,
-			market.BitcoinPercentageOfMarketCap,
+			humanize.Percentf(market.BitcoinPercentageOfMarketCap, 2),
 		)
 	}
 
@@ -0,0 +1,69 @@
+package cointop
+
+import (
+	"fmt"
+
+	"github.com/miguelmota/cointop/pkg/open"
+)
+
+// MarkIndicator is the prefix of marked coin names
+const MarkIndicator = "»"
+
+// IsMarked returns true if the coin is marked
+func (ct *Cointop) IsMarked(coin *Coin) bool {
+	if coin == nil {
+		return false
+	}
+	return ct.State.markedCoins[coin.Name]
+}
+
+// ToggleMark marks or unmarks the highlighted coin
+func (ct *Cointop) ToggleMark() error {
+	ct.debuglog("toggleMark()")
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return nil
+	}
+
+	if ct.State.markedCoins[coin.Name] {
+		delete(ct.State.markedCoins, coin.Name)
+	} else {
+		ct.State.markedCoins[coin.Name] = true
+	}
+
+	go ct.UpdateTable()
+	if ct.State.chartCompare {
+		go ct.UpdateChart()
+	}
+	return nil
+}
+
+// MarkedCoins returns the marked coins in the order of the coins list
+func (ct *Cointop) MarkedCoins() []*Coin {
+	var list []*Coin
+	for _, coin := range ct.State.allCoins {
+		if ct.IsMarked(coin) {
+			list = append(list, coin)
+		}
+	}
+	return list
+}
+
+// OpenMarkedLinks opens the links of all the marked coins in the browser and clears the marks.
+// With no marked coins it opens the link of the highlighted coin
+func (ct *Cointop) OpenMarkedLinks() error {
+	ct.debuglog("openMarkedLinks()")
+	coins := ct.MarkedCoins()
+	if len(coins) == 0 {
+		return ct.OpenLink()
+	}
+
+	for _, coin := range coins {
+		open.URL(ct.API().CoinLink(coin.Name))
+	}
+
+	ct.State.markedCoins = make(map[string]bool)
+	ct.UpdateStatusbar(fmt.Sprintf("Opened %d links", len(coins)))
+	go ct.UpdateTable()
+	return nil
+}
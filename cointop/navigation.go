@@ -50,6 +50,9 @@ func (ct *Cointop) CursorDown() error {
 	if ct.IsLastRow() {
 		return nil
 	}
+	if scrollOff := ct.ScrollOffRows(); scrollOff > 0 {
+		return ct.moveCursorWithScrollOff(1, scrollOff)
+	}
 
 	cx, cy := ct.Views.Table.Cursor()
 	y := cy + 1
@@ -75,6 +78,9 @@ func (ct *Cointop) CursorUp() error {
 	if ct.IsFirstRow() {
 		return nil
 	}
+	if scrollOff := ct.ScrollOffRows(); scrollOff > 0 {
+		return ct.moveCursorWithScrollOff(-1, scrollOff)
+	}
 
 	ox, oy := ct.Views.Table.Origin()
 	cx, cy := ct.Views.Table.Cursor()
@@ -93,6 +99,54 @@ func (ct *Cointop) CursorUp() error {
 	return nil
 }
 
+// ScrollOffRows returns the number of rows to keep between the cursor and the top or bottom of the table
+func (ct *Cointop) ScrollOffRows() int {
+	scrollOff := ct.State.scrollOff
+	// NOTE: a scroll off of at least half the height keeps the cursor centered
+	if max := (ct.Views.Table.Height() - 1) / 2; scrollOff > max {
+		scrollOff = max
+	}
+	if scrollOff < 0 {
+		scrollOff = 0
+	}
+	return scrollOff
+}
+
+// moveCursorWithScrollOff moves the cursor by delta rows and scrolls the origin to keep it scrollOff rows from the edges
+func (ct *Cointop) moveCursorWithScrollOff(delta int, scrollOff int) error {
+	ox, oy := ct.Views.Table.Origin()
+	cx, cy := ct.Views.Table.Cursor()
+	h := ct.Views.Table.Height()
+	l := ct.TableRowsLen()
+	row := oy + cy + delta
+	if row < 0 || row >= l {
+		return nil
+	}
+
+	if row-oy > h-1-scrollOff {
+		oy = row - (h - 1 - scrollOff)
+	}
+	if row-oy < scrollOff {
+		oy = row - scrollOff
+	}
+	// the cursor moves closer to the edge once the table can't scroll further
+	if oy > l-h {
+		oy = l - h
+	}
+	if oy < 0 {
+		oy = 0
+	}
+
+	if err := ct.Views.Table.SetOrigin(ox, oy); err != nil {
+		return err
+	}
+	if err := ct.Views.Table.SetCursor(cx, row-oy); err != nil {
+		return err
+	}
+	ct.RowChanged()
+	return nil
+}
+
 // PageDown moves the cursor one page down
 func (ct *Cointop) PageDown() error {
 	ct.debuglog("pageDown()")
@@ -458,6 +512,59 @@ func (ct *Cointop) GoToCoinRow(coin *Coin) error {
 	return ct.GoToGlobalIndex(idx)
 }
 
+// TopMoversScopeAll looks for the top gainer and loser among all the loaded coins
+const TopMoversScopeAll = "all"
+
+// TopMoversScopePage looks for the top gainer and loser among the coins on the current page
+const TopMoversScopePage = "page"
+
+// GoToTopGainer navigates to the row of the coin with the biggest 24h gain
+func (ct *Cointop) GoToTopGainer() error {
+	ct.debuglog("goToTopGainer()")
+	return ct.goToTopMover(true)
+}
+
+// GoToTopLoser navigates to the row of the coin with the biggest 24h loss
+func (ct *Cointop) GoToTopLoser() error {
+	ct.debuglog("goToTopLoser()")
+	return ct.goToTopMover(false)
+}
+
+// goToTopMover navigates to the coin with the max or min 24h change regardless of the sort column
+func (ct *Cointop) goToTopMover(gainer bool) error {
+	if ct.IsPriceAlertsVisible() {
+		return nil
+	}
+
+	// NOTE: only the coins view is paginated, the other views have all their coins on the page
+	coins := ct.State.coins
+	global := ct.State.topMoversScope == TopMoversScopeAll && ct.State.selectedView == CoinsView
+	if global {
+		coins = ct.AllCoins()
+	}
+
+	idx := -1
+	for i, coin := range coins {
+		if coin == nil {
+			continue
+		}
+		if idx == -1 ||
+			(gainer && coin.PercentChange24H > coins[idx].PercentChange24H) ||
+			(!gainer && coin.PercentChange24H < coins[idx].PercentChange24H) {
+			idx = i
+		}
+	}
+	if idx == -1 {
+		return nil
+	}
+
+	if global {
+		return ct.GoToGlobalIndex(idx)
+	}
+
+	return ct.GoToCoinRow(coins[idx])
+}
+
 // GetGlobalCoinIndex returns the index of the coin in from the gloal coins list
 func (ct *Cointop) GetGlobalCoinIndex(coin *Coin) int {
 	var idx int
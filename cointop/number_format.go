@@ -0,0 +1,52 @@
+package cointop
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/miguelmota/cointop/pkg/humanize"
+)
+
+// validateSeparators returns an error if the separators can't be told apart in a formatted number. The decimal
+// separator is a single character and the thousands separator is a single character or empty
+func validateSeparators(thousands string, decimal string) error {
+	if utf8.RuneCountInString(decimal) != 1 {
+		return fmt.Errorf("invalid decimal_separator %q. It must be a single character", decimal)
+	}
+	if utf8.RuneCountInString(thousands) > 1 {
+		return fmt.Errorf("invalid thousands_separator %q. It must be a single character or empty", thousands)
+	}
+	for _, sep := range []string{thousands, decimal} {
+		for _, r := range sep {
+			if unicode.IsDigit(r) || r == '-' {
+				return fmt.Errorf("invalid separator %q. It must not be a digit or minus sign", sep)
+			}
+		}
+	}
+	if thousands == decimal {
+		return fmt.Errorf("thousands_separator and decimal_separator must be different")
+	}
+	return nil
+}
+
+// loadNumberFormatFromConfig loads the thousands and decimal separators from config file to struct
+func (ct *Cointop) loadNumberFormatFromConfig() error {
+	ct.debuglog("loadNumberFormatFromConfig()")
+	thousands := ct.State.thousandsSeparator
+	if v, ok := ct.config.ThousandsSep.(string); ok {
+		thousands = v
+	}
+	decimal := ct.State.decimalSeparator
+	if v, ok := ct.config.DecimalSep.(string); ok {
+		decimal = v
+	}
+	if err := validateSeparators(thousands, decimal); err != nil {
+		return err
+	}
+
+	ct.State.thousandsSeparator = thousands
+	ct.State.decimalSeparator = decimal
+	humanize.SetSeparators(thousands, decimal)
+	return nil
+}
@@ -0,0 +1,76 @@
+package cointop
+
+import (
+	"time"
+)
+
+// DefaultStartupTimeout is how long the startup network check waits for the API to respond
+const DefaultStartupTimeout = 5 * time.Second
+
+// OfflineStatus is shown in the statusbar while in offline mode
+const OfflineStatus = "Offline: showing cached data"
+
+// pingAPI pings the API and gives up after the timeout
+func (ct *Cointop) pingAPI(timeout time.Duration) error {
+	ct.debuglog("pingAPI()")
+	done := make(chan error, 1)
+	go func() {
+		done <- ct.API().Ping()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return ErrPingTimeout
+	}
+}
+
+// IsOffline returns true if cointop is in offline mode, showing cached data without fetching
+func (ct *Cointop) IsOffline() bool {
+	return ct.State.offline
+}
+
+// checkOnline leaves offline mode if the API, or a fallback API, responds and returns true if cointop is online.
+// Offline mode set with the offline flag is kept until a manual refresh
+func (ct *Cointop) checkOnline() bool {
+	if !ct.State.offline {
+		return true
+	}
+	if ct.State.offlineForced {
+		return false
+	}
+	if err := ct.pingAPI(ct.State.startupTimeout); err != nil {
+		ct.debuglog(err.Error())
+		if len(ct.apiFallbacks) == 0 || ct.SelectAvailableAPI() != nil {
+			return false
+		}
+	}
+
+	ct.State.offline = false
+	ct.UpdateStatusbar("")
+	return true
+}
+
+// checkOffline enters offline mode after a failed refresh if the API doesn't respond, so the last fetched data is
+// kept with the offline status until the API responds again
+func (ct *Cointop) checkOffline() {
+	if ct.State.offline {
+		return
+	}
+	if err := ct.pingAPI(ct.State.startupTimeout); err == nil {
+		return
+	}
+
+	ct.debuglog("checkOffline() API unreachable")
+	ct.State.offline = true
+	// NOTE: the statusbar is updated in the background since it's called with the coins lock held
+	go ct.UpdateStatusbar("")
+}
+
+// ManualRefresh refreshes the data, first trying to leave offline mode even if it was set with the offline flag
+func (ct *Cointop) ManualRefresh() error {
+	ct.debuglog("manualRefresh()")
+	ct.State.offlineForced = false
+	return ct.Refresh()
+}
@@ -0,0 +1,112 @@
+package cointop
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// MaxPerPage is the max number of rows per page
+const MaxPerPage = 250
+
+// validatePerPage returns an error if the number of rows per page is out of range
+func validatePerPage(perPage int) error {
+	if perPage < 1 || perPage > MaxPerPage {
+		return fmt.Errorf("per page must be between 1 and %d", MaxPerPage)
+	}
+	return nil
+}
+
+// SetPerPage sets the number of rows per page
+func (ct *Cointop) SetPerPage(perPage int) error {
+	ct.debuglog("setPerPage()")
+	if err := validatePerPage(perPage); err != nil {
+		return err
+	}
+
+	ct.State.perPage = perPage
+	if ct.State.page > ct.TotalPages() {
+		ct.State.page = ct.TotalPages()
+	}
+
+	go ct.UpdateTable()
+	return nil
+}
+
+// UpdatePerPageMenu updates the per page menu view
+func (ct *Cointop) UpdatePerPageMenu(errMsg string) error {
+	ct.debuglog("updatePerPageMenu()")
+	value := strconv.Itoa(ct.State.perPage)
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" Set Per Page %s\n\n", pad.Left("[q] close ", ct.width()-14, " ")))
+	label := fmt.Sprintf(" Enter number of coins per page (1-%d) %s", MaxPerPage, ct.colorscheme.MenuLabel(fmt.Sprintf("(current %s)", value)))
+	var errText string
+	if errMsg != "" {
+		errText = fmt.Sprintf("\n\n %s", errMsg)
+	}
+	content := fmt.Sprintf("%s\n%s\n\n\n\n\n [Enter] Set    [ESC] Cancel%s", header, label, errText)
+
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(true)
+		ct.Views.Menu.Update(content)
+		if errMsg == "" {
+			ct.Views.Input.Update(value)
+			ct.Views.Input.SetCursor(utf8.RuneCountInString(value), 0)
+		}
+		return nil
+	})
+	return nil
+}
+
+// ShowPerPageMenu shows the per page menu
+func (ct *Cointop) ShowPerPageMenu() error {
+	ct.debuglog("showPerPageMenu()")
+	ct.State.perPageMenuVisible = true
+	ct.UpdatePerPageMenu("")
+	ct.ui.SetCursor(true)
+	ct.SetActiveView(ct.Views.Menu.Name())
+	ct.g.SetViewOnTop(ct.Views.Input.Name())
+	ct.g.SetCurrentView(ct.Views.Input.Name())
+	return nil
+}
+
+// HidePerPageMenu hides the per page menu
+func (ct *Cointop) HidePerPageMenu() error {
+	ct.debuglog("hidePerPageMenu()")
+	ct.State.perPageMenuVisible = false
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.ui.SetViewOnBottom(ct.Views.Input)
+	ct.ui.SetCursor(false)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		ct.Views.Menu.Update("")
+		ct.Views.Input.Update("")
+		return nil
+	})
+
+	return nil
+}
+
+// SetPerPageFromInput sets the number of rows per page from the inputed value
+func (ct *Cointop) SetPerPageFromInput() error {
+	ct.debuglog("setPerPageFromInput()")
+
+	// read input field from the start since it may have been read after invalid input
+	ct.Views.Input.Rewind()
+	b := make([]byte, 100)
+	n, _ := ct.Views.Input.Read(b)
+	value := strings.TrimSpace(string(b[:n]))
+	perPage, err := strconv.Atoi(value)
+	if err != nil {
+		return ct.UpdatePerPageMenu(fmt.Sprintf("Invalid number %q", value))
+	}
+	if err := ct.SetPerPage(perPage); err != nil {
+		return ct.UpdatePerPageMenu(err.Error())
+	}
+
+	ct.HidePerPageMenu()
+	return ct.Save()
+}
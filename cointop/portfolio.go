@@ -31,6 +31,10 @@ var SupportedPortfolioTableHeaders = []string{
 	"7d_change",
 	"30d_change",
 	"percent_holdings",
+	"cost_price",
+	"cost",
+	"pnl",
+	"pnl_percent",
 	"last_updated",
 }
 
@@ -60,6 +64,15 @@ func (ct *Cointop) ValidPortfolioTableHeader(name string) bool {
 	return false
 }
 
+// HoldingsPrecisionFull displays holdings with full precision
+const HoldingsPrecisionFull = -1
+
+// HoldingsPrecisionAuto displays holdings rounded to a number of significant digits
+const HoldingsPrecisionAuto = -2
+
+// HoldingsSignificantDigits is the number of significant digits shown for auto holdings precision
+const HoldingsSignificantDigits = 6
+
 // GetPortfolioTableHeaders returns the portfolio table headers
 func (ct *Cointop) GetPortfolioTableHeaders() []string {
 	return ct.State.portfolioTableColumns
@@ -68,6 +81,8 @@ func (ct *Cointop) GetPortfolioTableHeaders() []string {
 // GetPortfolioTable returns the table for displaying portfolio holdings
 func (ct *Cointop) GetPortfolioTable() *table.Table {
 	total := ct.GetPortfolioTotal()
+	// NOTE: the values are left out until the rate to the portfolio currency is fetched instead of mixing currencies
+	rate, rateOK := ct.portfolioRate()
 	maxX := ct.width()
 	t := table.NewTable().SetWidth(maxX)
 	var rows [][]*table.RowCell
@@ -102,6 +117,10 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 				if coin.Favorite {
 					namecolor = ct.colorscheme.TableRowFavorite
 				}
+				if ct.IsMarked(coin) {
+					name = fmt.Sprintf("%s %s", MarkIndicator, name)
+					namecolor = ct.colorscheme.TableColumnChangeUp
+				}
 				ct.SetTableColumnWidthFromString(header, name)
 				ct.SetTableColumnAlignLeft(header, true)
 				rowCells = append(rowCells,
@@ -125,7 +144,10 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 						Text:        symbol,
 					})
 			case "price":
-				text := humanize.Commaf(coin.Price)
+				text := PortfolioRatePending
+				if rateOK {
+					text = ct.FormatPrice(coin.Price * rate)
+				}
 				symbolPadding := 1
 				ct.SetTableColumnWidth(header, utf8.RuneCountInString(text)+symbolPadding)
 				ct.SetTableColumnAlignLeft(header, false)
@@ -134,11 +156,11 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 						LeftMargin:  leftMargin,
 						RightMargin: rightMargin,
 						LeftAlign:   false,
-						Color:       ct.colorscheme.TableRow,
+						Color:       ct.priceTickColor(coin, ct.colorscheme.TableRow),
 						Text:        text,
 					})
 			case "holdings":
-				text := strconv.FormatFloat(coin.Holdings, 'f', -1, 64)
+				text := ct.MaskValue(ct.FormatHoldings(coin.Holdings))
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -150,7 +172,10 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 						Text:        text,
 					})
 			case "balance":
-				text := humanize.Commaf(coin.Balance)
+				text := PortfolioRatePending
+				if rateOK {
+					text = ct.MaskValue(humanize.Commaf(coin.Balance))
+				}
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				colorBalance := ct.colorscheme.TableColumnPrice
@@ -170,7 +195,7 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 				if coin.PercentChange1H < 0 {
 					color1h = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange1H)
+				text := humanize.Percentf(coin.PercentChange1H, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -189,7 +214,7 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 				if coin.PercentChange24H < 0 {
 					color24h = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange24H)
+				text := humanize.Percentf(coin.PercentChange24H, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -208,7 +233,7 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 				if coin.PercentChange7D < 0 {
 					color7d = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange7D)
+				text := humanize.Percentf(coin.PercentChange7D, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -227,7 +252,7 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 				if coin.PercentChange30D < 0 {
 					color30d = ct.colorscheme.TableColumnChangeDown
 				}
-				text := fmt.Sprintf("%.2f%%", coin.PercentChange30D)
+				text := humanize.Percentf(coin.PercentChange30D, 2)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -243,7 +268,10 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 				if math.IsNaN(percentHoldings) {
 					percentHoldings = 0
 				}
-				text := fmt.Sprintf("%.2f%%", percentHoldings)
+				text := PortfolioRatePending
+				if rateOK {
+					text = humanize.Percentf(percentHoldings, 2)
+				}
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells,
@@ -254,6 +282,56 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 						Color:       ct.colorscheme.TableRow,
 						Text:        text,
 					})
+			case "cost_price", "cost":
+				var text string
+				if coin.Cost > 0 {
+					value := coin.Cost
+					if header == "cost_price" {
+						value = coin.CostPrice
+					}
+					text = humanize.Commaf(value)
+					if header == "cost" {
+						text = ct.MaskValue(text)
+					}
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       ct.colorscheme.TableColumnPrice,
+						Text:        text,
+					})
+			case "pnl", "pnl_percent":
+				colorPnl := ct.colorscheme.TableColumnChange
+				if coin.ProfitLoss > 0 {
+					colorPnl = ct.colorscheme.TableColumnChangeUp
+				}
+				if coin.ProfitLoss < 0 {
+					colorPnl = ct.colorscheme.TableColumnChangeDown
+				}
+				var text string
+				if coin.Cost > 0 && !rateOK {
+					text = PortfolioRatePending
+				} else if coin.Cost > 0 {
+					if header == "pnl" {
+						text = ct.MaskValue(humanize.Commaf2(coin.ProfitLoss))
+					} else {
+						text = humanize.Percentf(coin.PercentProfitLoss, 2)
+					}
+				}
+				ct.SetTableColumnWidthFromString(header, text)
+				ct.SetTableColumnAlignLeft(header, false)
+				rowCells = append(rowCells,
+					&table.RowCell{
+						LeftMargin:  leftMargin,
+						RightMargin: rightMargin,
+						LeftAlign:   false,
+						Color:       colorPnl,
+						Text:        text,
+					})
 			case "last_updated":
 				unix, _ := strconv.ParseInt(coin.LastUpdated, 10, 64)
 				lastUpdated := time.Unix(unix, 0).Format("15:04:05 Jan 02")
@@ -273,6 +351,7 @@ func (ct *Cointop) GetPortfolioTable() *table.Table {
 		rows = append(rows, rowCells)
 	}
 
+	ct.ResolveTableColumnWidths(headers)
 	for _, row := range rows {
 		for i, header := range headers {
 			row[i].Width = ct.GetTableColumnWidth(header)
@@ -315,6 +394,51 @@ func (ct *Cointop) TogglePortfolioUpdateMenu() error {
 	return ct.HidePortfolioUpdateMenu()
 }
 
+// QuickAddPortfolioEntry adds the highlighted coin to the portfolio with zero holdings and opens the edit menu to
+// optionally enter the holdings or a buy lot with its cost. A coin already in the portfolio opens the edit menu instead
+func (ct *Cointop) QuickAddPortfolioEntry() error {
+	ct.debuglog("quickAddPortfolioEntry()")
+	if ct.IsPriceAlertsVisible() {
+		return nil
+	}
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return nil
+	}
+	if !ct.PortfolioEntryExists(coin) {
+		if err := ct.SetPortfolioEntry(coin.Name, 0); err != nil {
+			return err
+		}
+		ct.UpdateTable()
+	}
+
+	return ct.ShowPortfolioUpdateMenu()
+}
+
+// FormatHoldings returns the holdings amount rounded for display using the configured precision and separators
+func (ct *Cointop) FormatHoldings(holdings float64) string {
+	switch precision := ct.State.holdingsPrecision; {
+	case precision == HoldingsPrecisionAuto:
+		if holdings == 0 || math.IsNaN(holdings) || math.IsInf(holdings, 0) {
+			return humanize.Commaf(holdings)
+		}
+		decimals := HoldingsSignificantDigits - int(math.Floor(math.Log10(math.Abs(holdings)))) - 1
+		if decimals < 0 {
+			decimals = 0
+		}
+		// NOTE: the decimal places are cut to the last non zero digit so 1.5 isn't shown as 1.50000
+		text := strconv.FormatFloat(holdings, 'f', decimals, 64)
+		if i := strings.Index(text, "."); i >= 0 {
+			decimals = len(strings.TrimRight(text, "0")) - i - 1
+		}
+		return humanize.Commafd(holdings, decimals)
+	case precision >= 0:
+		return humanize.Commafd(holdings, precision)
+	default:
+		return humanize.Commaf(holdings)
+	}
+}
+
 // CoinHoldings returns portfolio coin holdings
 func (ct *Cointop) CoinHoldings(coin *Coin) float64 {
 	entry, _ := ct.PortfolioEntry(coin)
@@ -333,7 +457,13 @@ func (ct *Cointop) UpdatePortfolioUpdateMenu() error {
 	var submitText string
 	if exists {
 		mode = "Edit"
-		current = fmt.Sprintf("(current %s %s)", value, coin.Symbol)
+		// NOTE: the input keeps the plain value so it can be parsed back
+		holdings := ct.FormatHoldings(ct.CoinHoldings(coin))
+		current = fmt.Sprintf("(current %s %s)", holdings, coin.Symbol)
+		entry, _ := ct.PortfolioEntry(coin)
+		if len(entry.Lots) > 0 {
+			current = fmt.Sprintf("(current %s %s, %d lots, avg cost %s%s)", holdings, coin.Symbol, len(entry.Lots), ct.PortfolioCurrencySymbol(), humanize.Commaf(LotsAverageCost(entry.Lots)))
+		}
 		submitText = "Set"
 	} else {
 		mode = "Add"
@@ -341,7 +471,8 @@ func (ct *Cointop) UpdatePortfolioUpdateMenu() error {
 	}
 	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" %s Portfolio Entry %s\n\n", mode, pad.Left("[q] close ", ct.width()-25, " ")))
 	label := fmt.Sprintf(" Enter holdings for %s %s", ct.colorscheme.MenuLabel(coin.Name), current)
-	content := fmt.Sprintf("%s\n%s\n\n%s%s\n\n\n [Enter] %s    [ESC] Cancel", header, label, strings.Repeat(" ", 29), coin.Symbol, submitText)
+	lotText := ct.colorscheme.MenuLabel(" Enter amount@price to set the holdings with the average buy price\n Enter +amount@price[@YYYY-MM-DD] to add a buy lot instead")
+	content := fmt.Sprintf("%s\n%s\n\n%s%s\n\n\n [Enter] %s    [ESC] Cancel\n\n%s", header, label, strings.Repeat(" ", 29), coin.Symbol, submitText, lotText)
 
 	ct.UpdateUI(func() error {
 		ct.Views.Menu.SetFrame(true)
@@ -399,7 +530,13 @@ func (ct *Cointop) HidePortfolioUpdateMenu() error {
 // SetPortfolioHoldings sets portfolio entry holdings from inputed value
 func (ct *Cointop) SetPortfolioHoldings() error {
 	ct.debuglog("setPortfolioHoldings()")
-	defer ct.HidePortfolioUpdateMenu()
+	confirming := false
+	defer func() {
+		// NOTE: the confirmation menu replaces the update menu so it's hidden beforehand
+		if !confirming {
+			ct.HidePortfolioUpdateMenu()
+		}
+	}()
 	coin := ct.HighlightedRowCoin()
 	if coin == nil {
 		return nil
@@ -415,29 +552,56 @@ func (ct *Cointop) SetPortfolioHoldings() error {
 		return nil
 	}
 
-	value := normalizeFloatString(string(b))
-	shouldDelete := value == ""
-	var holdings float64
-
-	if !shouldDelete {
-		holdings, err = strconv.ParseFloat(value, 64)
+	if input := strings.TrimSpace(string(b[:n])); strings.HasPrefix(input, "+") {
+		lot, err := ParsePortfolioLot(input)
+		if err != nil {
+			return err
+		}
+		if err := ct.AddPortfolioLot(coin.Name, lot); err != nil {
+			return err
+		}
+		ct.UpdateTable()
+		ct.GoToPageRowIndex(ct.State.lastSelectedRowIndex)
+		ct.ToggleShowPortfolio()
+		return nil
+	} else if strings.Contains(input, "@") {
+		lot, err := ParsePortfolioLot(input)
 		if err != nil {
 			return err
 		}
+		if err := ct.SetPortfolioCostBasis(coin.Name, lot); err != nil {
+			return err
+		}
+		ct.UpdateTable()
+		ct.GoToPageRowIndex(ct.State.lastSelectedRowIndex)
+		ct.ToggleShowPortfolio()
+		return nil
 	}
 
-	if err := ct.SetPortfolioEntry(coin.Name, holdings); err != nil {
+	value := normalizeFloatString(string(b))
+	if value == "" {
+		if ct.State.confirmRemoval && ct.PortfolioEntryExists(coin) {
+			confirming = true
+			ct.HidePortfolioUpdateMenu()
+			return ct.ShowConfirmMenu(fmt.Sprintf("Remove %s from the portfolio?", coin.Name), func() error {
+				return ct.removePortfolioCoin(coin)
+			})
+		}
+		return ct.removePortfolioCoin(coin)
+	}
+
+	holdings, err := strconv.ParseFloat(value, 64)
+	if err != nil {
 		return err
 	}
 
-	if shouldDelete {
-		ct.RemovePortfolioEntry(coin.Name)
-		ct.UpdateTable()
-	} else {
-		ct.UpdateTable()
-		ct.GoToPageRowIndex(ct.State.lastSelectedRowIndex)
+	if err := ct.SetPortfolioEntry(coin.Name, holdings); err != nil {
+		return err
 	}
 
+	ct.UpdateTable()
+	ct.GoToPageRowIndex(ct.State.lastSelectedRowIndex)
+
 	if err := ct.Save(); err != nil {
 		return err
 	}
@@ -475,6 +639,17 @@ func (ct *Cointop) PortfolioEntry(c *Coin) (*PortfolioEntry, bool) {
 // SetPortfolioEntry sets a portfolio entry
 func (ct *Cointop) SetPortfolioEntry(coin string, holdings float64) error {
 	ct.debuglog("setPortfolioEntry()")
+	ct.setPortfolioEntry(coin, holdings)
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// setPortfolioEntry sets a portfolio entry without saving. Setting the holdings directly discards any lots
+func (ct *Cointop) setPortfolioEntry(coin string, holdings float64) {
 	ic, _ := ct.State.allCoinsSlugMap.Load(strings.ToLower(coin))
 	c, _ := ic.(*Coin)
 	p, isNew := ct.PortfolioEntry(c)
@@ -486,13 +661,8 @@ func (ct *Cointop) SetPortfolioEntry(coin string, holdings float64) error {
 		}
 	} else {
 		p.Holdings = holdings
+		p.Lots = nil
 	}
-
-	if err := ct.Save(); err != nil {
-		return err
-	}
-
-	return nil
 }
 
 // RemovePortfolioEntry removes a portfolio entry
@@ -522,6 +692,9 @@ func (ct *Cointop) GetPortfolioSlice() []*Coin {
 		return sliced
 	}
 
+	currency := ct.PortfolioCurrency()
+	rate, rateOK := ct.portfolioRate()
+
 	for i := range ct.State.allCoins {
 		coin := ct.State.allCoins[i]
 		p, isNew := ct.PortfolioEntry(coin)
@@ -529,13 +702,23 @@ func (ct *Cointop) GetPortfolioSlice() []*Coin {
 			continue
 		}
 		coin.Holdings = p.Holdings
-		balance := coin.Price * p.Holdings
+		price := coin.Price * rate
+		balance := price * p.Holdings
 		balancestr := fmt.Sprintf("%.2f", balance)
-		if ct.State.currencyConversion == "ETH" || ct.State.currencyConversion == "BTC" {
+		if currency == "ETH" || currency == "BTC" {
 			balancestr = fmt.Sprintf("%.5f", balance)
 		}
 		balance, _ = strconv.ParseFloat(balancestr, 64)
 		coin.Balance = balance
+		cost, amount := LotsCost(p.Lots)
+		coin.CostPrice = LotsAverageCost(p.Lots)
+		coin.Cost = cost
+		coin.ProfitLoss = 0
+		coin.PercentProfitLoss = 0
+		if cost > 0 && rateOK {
+			coin.ProfitLoss = price*amount - cost
+			coin.PercentProfitLoss = (coin.ProfitLoss / cost) * 1e2
+		}
 		sliced = append(sliced, coin)
 	}
 
@@ -550,6 +733,50 @@ func (ct *Cointop) GetPortfolioSlice() []*Coin {
 	return sliced
 }
 
+// PortfolioRatePending is shown in place of the portfolio values until the rate to the portfolio currency is fetched
+const PortfolioRatePending = "rate pending"
+
+// PortfolioCurrency returns the currency of the portfolio values and the lot costs. It's the portfolio currency if
+// set, otherwise the currency conversion
+func (ct *Cointop) PortfolioCurrency() string {
+	if ct.State.portfolioCurrency == "" {
+		return ct.State.currencyConversion
+	}
+	return ct.State.portfolioCurrency
+}
+
+// PortfolioCurrencySymbol returns the symbol of the portfolio currency
+func (ct *Cointop) PortfolioCurrencySymbol() string {
+	return CurrencySymbol(ct.PortfolioCurrency())
+}
+
+// portfolioRate returns the cross rate from the currency conversion to the portfolio currency.
+// It returns false until the rate is fetched, since the values can't be compared to the lot costs without it
+func (ct *Cointop) portfolioRate() (float64, bool) {
+	return ct.priceCurrencyRate(ct.PortfolioCurrency())
+}
+
+// fetchPortfolioRate fetches the rate to the portfolio currency if it's missing. It returns an error if the rate isn't
+// available so the holdings aren't printed in mixed currencies
+func (ct *Cointop) fetchPortfolioRate() error {
+	if !ct.IsPortfolioRatePending() {
+		return nil
+	}
+	if err := ct.fetchPriceCurrencyRates(); err != nil {
+		return err
+	}
+	if ct.IsPortfolioRatePending() {
+		return fmt.Errorf("the rate from %s to the portfolio currency %s isn't available", ct.State.currencyConversion, ct.PortfolioCurrency())
+	}
+	return nil
+}
+
+// IsPortfolioRatePending returns true if the portfolio values are waiting on the rate to the portfolio currency
+func (ct *Cointop) IsPortfolioRatePending() bool {
+	_, ok := ct.portfolioRate()
+	return !ok
+}
+
 // GetPortfolioTotal returns the total balance of portfolio entries
 func (ct *Cointop) GetPortfolioTotal() float64 {
 	ct.debuglog("getPortfolioTotal()")
@@ -561,16 +788,41 @@ func (ct *Cointop) GetPortfolioTotal() float64 {
 	return total
 }
 
+// GetPortfolioChange24H returns the 24h change of the portfolio value in the portfolio currency and its percent of the
+// value 24h ago. It's the sum of the change of each entry since its value 24h ago, so an empty portfolio has no change
+func (ct *Cointop) GetPortfolioChange24H() (float64, float64) {
+	var total, change24H float64
+	for _, p := range ct.GetPortfolioSlice() {
+		// NOTE: a loss of 100% or more has no value 24h ago to compare to
+		ratio := 1 + p.PercentChange24H/100
+		if ratio <= 0 {
+			continue
+		}
+		total += p.Balance
+		change24H += p.Balance - p.Balance/ratio
+	}
+	if total-change24H == 0 {
+		return change24H, 0
+	}
+	return change24H, change24H / (total - change24H) * 100
+}
+
 // RefreshPortfolioCoins refreshes portfolio entry coin data
 func (ct *Cointop) RefreshPortfolioCoins() error {
 	ct.debuglog("refreshPortfolioCoins()")
+	if ct.IsOffline() {
+		return ErrOffline
+	}
 	holdings := ct.GetPortfolioSlice()
 	holdingCoins := make([]string, len(holdings))
 	for i, entry := range holdings {
 		holdingCoins[i] = entry.Name
 	}
 
-	coins, err := ct.api.GetCoinDataBatch(holdingCoins, ct.State.currencyConversion)
+	coins, err := ct.API().GetCoinDataBatch(holdingCoins, ct.State.currencyConversion)
+	if err != nil && ct.failoverAPI() {
+		coins, err = ct.API().GetCoinDataBatch(holdingCoins, ct.State.currencyConversion)
+	}
 	ct.processCoins(coins)
 	if err != nil {
 		return err
@@ -613,11 +865,19 @@ func (ct *Cointop) PrintHoldingsTable(options *TablePrintOptions) error {
 		options = &TablePrintOptions{}
 	}
 
-	if err := ct.SetCurrencyConverstion(options.Convert); err != nil {
+	// NOTE: the coins are fetched in the portfolio currency unless another currency is given, which needs a cross rate
+	convert := options.Convert
+	if convert == "" {
+		convert = ct.State.portfolioCurrency
+	}
+	if err := ct.SetCurrencyConverstion(convert); err != nil {
 		return err
 	}
 
 	ct.RefreshPortfolioCoins()
+	if err := ct.fetchPortfolioRate(); err != nil {
+		return err
+	}
 
 	sortBy := options.SortBy
 	sortDesc := options.SortDesc
@@ -644,7 +904,7 @@ func (ct *Cointop) PrintHoldingsTable(options *TablePrintOptions) error {
 
 	total := ct.GetPortfolioTotal()
 	records := make([][]string, len(holdings))
-	symbol := ct.CurrencySymbol()
+	symbol := ct.PortfolioCurrencySymbol()
 
 	for i, entry := range holdings {
 		if len(filter) > 0 {
@@ -671,10 +931,10 @@ func (ct *Cointop) PrintHoldingsTable(options *TablePrintOptions) error {
 				entry.Name,
 				entry.Symbol,
 				fmt.Sprintf("%s%s", symbol, humanize.Commaf(entry.Price)),
-				humanize.Commaf(entry.Holdings),
+				ct.FormatHoldings(entry.Holdings),
 				fmt.Sprintf("%s%s", symbol, humanize.Commaf(entry.Balance)),
-				fmt.Sprintf("%.2f%%", entry.PercentChange24H),
-				fmt.Sprintf("%.2f%%", percentHoldings),
+				humanize.Percentf(entry.PercentChange24H, 2),
+				humanize.Percentf(percentHoldings, 2),
 			}
 		} else {
 			records[i] = []string{
@@ -747,14 +1007,22 @@ func (ct *Cointop) PrintTotalHoldings(options *TablePrintOptions) error {
 		options = &TablePrintOptions{}
 	}
 
-	if err := ct.SetCurrencyConverstion(options.Convert); err != nil {
+	// NOTE: the coins are fetched in the portfolio currency unless another currency is given, which needs a cross rate
+	convert := options.Convert
+	if convert == "" {
+		convert = ct.State.portfolioCurrency
+	}
+	if err := ct.SetCurrencyConverstion(convert); err != nil {
 		return err
 	}
 
 	ct.RefreshPortfolioCoins()
+	if err := ct.fetchPortfolioRate(); err != nil {
+		return err
+	}
 
 	humanReadable := options.HumanReadable
-	symbol := ct.CurrencySymbol()
+	symbol := ct.PortfolioCurrencySymbol()
 	format := options.Format
 	filter := options.Filter
 	portfolio := ct.GetPortfolioSlice()
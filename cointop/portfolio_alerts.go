@@ -0,0 +1,163 @@
+package cointop
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PortfolioAlert is an alert on the 24h percent change of the portfolio value
+type PortfolioAlert struct {
+	ID            string
+	Operator      string
+	TargetPercent float64
+	Frequency     string
+	LastTriggered time.Time
+	Expired       bool
+}
+
+// NewPortfolioAlert returns a portfolio alert
+func NewPortfolioAlert(operator string, targetPercent float64, frequency string) *PortfolioAlert {
+	return &PortfolioAlert{
+		ID:            strings.ToLower(fmt.Sprintf("portfolio_%s_%v_%s", operator, targetPercent, frequency)),
+		Operator:      operator,
+		TargetPercent: targetPercent,
+		Frequency:     frequency,
+	}
+}
+
+// CheckPortfolioAlert checks the portfolio alert against the 24h percent change of the portfolio value
+func (ct *Cointop) CheckPortfolioAlert(alert *PortfolioAlert) error {
+	ct.debuglog("checkPortfolioAlert()")
+	if alert.Expired {
+		return nil
+	}
+	if alert.Frequency == "reoccurring" && time.Since(alert.LastTriggered) < PriceAlertCooldown {
+		return nil
+	}
+	if len(ct.State.portfolio.Entries) == 0 || ct.GetPortfolioTotal() == 0 {
+		return nil
+	}
+
+	_, percentChange24H := ct.GetPortfolioChange24H()
+	var triggered bool
+	switch alert.Operator {
+	case ">":
+		triggered = percentChange24H > alert.TargetPercent
+	case ">=":
+		triggered = percentChange24H >= alert.TargetPercent
+	case "<":
+		triggered = percentChange24H < alert.TargetPercent
+	case "<=":
+		triggered = percentChange24H <= alert.TargetPercent
+	case "=":
+		triggered = percentChange24H == alert.TargetPercent
+	}
+	if !triggered {
+		return nil
+	}
+
+	title := "Cointop Alert"
+	msg := fmt.Sprintf("Portfolio 24h change is %s %v%% (%.2f%%)", PriceAlertOperatorMap[alert.Operator], alert.TargetPercent, percentChange24H)
+	ct.notifyAlert(title, msg, &PortfolioAlertWebhookPayload{
+		Text:          msg,
+		Content:       msg,
+		Operator:      alert.Operator,
+		TargetPercent: alert.TargetPercent,
+		PercentChange: percentChange24H,
+		Currency:      ct.PortfolioCurrency(),
+		Frequency:     alert.Frequency,
+	})
+
+	alert.LastTriggered = time.Now()
+	if alert.Frequency == "once" {
+		alert.Expired = true
+	}
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// PortfolioAlertWebhookPayload is the JSON posted to the webhook when a portfolio alert triggers
+type PortfolioAlertWebhookPayload struct {
+	Text          string  `json:"text"`
+	Content       string  `json:"content"`
+	Operator      string  `json:"operator"`
+	TargetPercent float64 `json:"target_percent"`
+	PercentChange float64 `json:"percent_change_24h"`
+	Currency      string  `json:"currency"`
+	Frequency     string  `json:"frequency"`
+}
+
+// portfolioAlertsToToml returns the portfolio alerts that haven't expired as config tuples
+func (ct *Cointop) portfolioAlertsToToml() []interface{} {
+	var portfolioAlertsIfc []interface{}
+	for _, alert := range ct.State.priceAlerts.Portfolio {
+		if alert.Expired {
+			continue
+		}
+		tuple := []string{
+			alert.Operator,
+			strconv.FormatFloat(alert.TargetPercent, 'f', -1, 64),
+			alert.Frequency,
+		}
+		// NOTE: the last triggered time is kept so a reoccurring alert doesn't notify again on restart within the cooldown
+		if !alert.LastTriggered.IsZero() {
+			tuple = append(tuple, alert.LastTriggered.UTC().Format(time.RFC3339))
+		}
+		portfolioAlertsIfc = append(portfolioAlertsIfc, tuple)
+	}
+	return portfolioAlertsIfc
+}
+
+// loadPortfolioAlertsFromConfig loads the portfolio alerts config tuples of operator, target percent, frequency and
+// optionally the last triggered time
+func (ct *Cointop) loadPortfolioAlertsFromConfig() error {
+	ct.debuglog("loadPortfolioAlertsFromConfig()")
+	portfolioAlertsIfc, ok := ct.config.PriceAlerts["portfolio_alerts"].([]interface{})
+	if !ok {
+		return nil
+	}
+	for _, alertIfc := range portfolioAlertsIfc {
+		tupleIfc, ok := alertIfc.([]interface{})
+		if !ok || len(tupleIfc) < 3 || len(tupleIfc) > 4 {
+			return ErrInvalidPriceAlert
+		}
+		operator, ok := tupleIfc[0].(string)
+		if !ok {
+			return ErrInvalidPriceAlert
+		}
+		if _, ok := PriceAlertOperatorMap[operator]; !ok {
+			return ErrInvalidPriceAlert
+		}
+		targetPercent, err := ct.InterfaceToFloat64(tupleIfc[1])
+		if err != nil {
+			return err
+		}
+		frequency, ok := tupleIfc[2].(string)
+		if !ok {
+			return ErrInvalidPriceAlert
+		}
+		if _, ok := PriceAlertFrequencyMap[frequency]; !ok {
+			return ErrInvalidPriceAlert
+		}
+		alert := NewPortfolioAlert(operator, targetPercent, frequency)
+		if len(tupleIfc) > 3 {
+			lastTriggeredStr, ok := tupleIfc[3].(string)
+			if !ok {
+				return ErrInvalidPriceAlert
+			}
+			lastTriggered, err := time.Parse(time.RFC3339, lastTriggeredStr)
+			if err != nil {
+				return ErrInvalidPriceAlert
+			}
+			alert.LastTriggered = lastTriggered
+		}
+		ct.State.priceAlerts.Portfolio = append(ct.State.priceAlerts.Portfolio, alert)
+	}
+
+	return nil
+}
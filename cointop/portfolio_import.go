@@ -0,0 +1,176 @@
+package cointop
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/pathutil"
+)
+
+// PortfolioImportResult is the result of a portfolio import
+type PortfolioImportResult struct {
+	Imported  int
+	Unmatched []string
+}
+
+// ImportPortfolioCSV imports portfolio holdings from a CSV file with rows of coin,holdings[,cost_basis].
+// The cost basis is the total amount paid for the holdings
+func (ct *Cointop) ImportPortfolioCSV(path string) error {
+	ct.debuglog("importPortfolioCSV()")
+	f, err := os.Open(pathutil.NormalizePath(path))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	result, err := ct.ImportPortfolio(f)
+	if err != nil {
+		return err
+	}
+
+	msg := fmt.Sprintf("Imported %d portfolio entries", result.Imported)
+	if len(result.Unmatched) > 0 {
+		msg = fmt.Sprintf("%s. Coins not found: %s", msg, strings.Join(result.Unmatched, ", "))
+	}
+	if !ct.IsRunning() {
+		fmt.Println(msg)
+		return nil
+	}
+
+	ct.UpdateStatusbar(msg)
+	go ct.UpdateTable()
+	return nil
+}
+
+// ImportPortfolio imports portfolio holdings from CSV rows of coin,holdings[,cost_basis].
+// A first row that doesn't have a number of holdings is skipped as the header
+func (ct *Cointop) ImportPortfolio(r io.Reader) (*PortfolioImportResult, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.Comment = '#'
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	type row struct {
+		name      string
+		holdings  float64
+		costBasis float64
+	}
+	var rows []*row
+	for i, record := range records {
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("invalid row %d. Expected coin,holdings[,cost_basis]", i+1)
+		}
+		holdings, err := strconv.ParseFloat(normalizeFloatString(record[1]), 64)
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("invalid holdings %q on row %d", record[1], i+1)
+		}
+		var costBasis float64
+		if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+			costBasis, err = strconv.ParseFloat(normalizeFloatString(record[2]), 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid cost basis %q on row %d", record[2], i+1)
+			}
+		}
+		rows = append(rows, &row{
+			name:      strings.TrimSpace(record[0]),
+			holdings:  holdings,
+			costBasis: costBasis,
+		})
+	}
+
+	var names []string
+	for _, row := range rows {
+		names = append(names, row.name)
+	}
+	resolved, err := ct.resolveCoinNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PortfolioImportResult{}
+	for _, row := range rows {
+		name, ok := resolved[strings.ToLower(row.name)]
+		if !ok {
+			result.Unmatched = append(result.Unmatched, row.name)
+			continue
+		}
+		ct.setPortfolioEntry(name, row.holdings)
+		if row.costBasis > 0 && row.holdings > 0 {
+			if p, ok := ct.State.portfolio.Entries[strings.ToLower(name)]; ok {
+				p.Lots = []*PortfolioLot{{
+					Amount: row.holdings,
+					Price:  row.costBasis / row.holdings,
+				}}
+			}
+		}
+		result.Imported++
+	}
+
+	if result.Imported > 0 {
+		if err := ct.Save(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// resolveCoinNames returns the coin names of the names, ids or symbols keyed by the lowercase input.
+// Inputs that aren't in the loaded coins are looked up with the API
+func (ct *Cointop) resolveCoinNames(inputs []string) (map[string]string, error) {
+	resolved := make(map[string]string)
+	var missing []string
+	for _, input := range inputs {
+		key := strings.ToLower(input)
+		if _, ok := resolved[key]; ok {
+			continue
+		}
+		if coin := ct.coinByNameOrSymbol(input); coin != nil {
+			resolved[key] = coin.Name
+		} else {
+			missing = append(missing, input)
+		}
+	}
+	if len(missing) == 0 || ct.IsOffline() {
+		return resolved, nil
+	}
+
+	coins, err := ct.API().GetCoinDataBatch(missing, ct.State.currencyConversion)
+	if err != nil {
+		return nil, err
+	}
+	for _, input := range missing {
+		for _, coin := range coins {
+			if strings.EqualFold(coin.Name, input) || strings.EqualFold(coin.ID, input) || strings.EqualFold(coin.Symbol, input) {
+				resolved[strings.ToLower(input)] = coin.Name
+				break
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// coinByNameOrSymbol returns the loaded coin with the name or id, or the highest ranked coin with the symbol
+func (ct *Cointop) coinByNameOrSymbol(input string) *Coin {
+	var bySymbol *Coin
+	for _, coin := range ct.State.allCoins {
+		if strings.EqualFold(coin.Name, input) || strings.EqualFold(coin.ID, input) {
+			return coin
+		}
+		if strings.EqualFold(coin.Symbol, input) && (bySymbol == nil || coin.Rank < bySymbol.Rank) {
+			bySymbol = coin
+		}
+	}
+	return bySymbol
+}
@@ -0,0 +1,116 @@
+package cointop
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/miguelmota/cointop/pkg/api"
+)
+
+// TestImportPortfolio checks the holdings and cost basis imported from the CSV rows
+func TestImportPortfolio(t *testing.T) {
+	tests := []struct {
+		name          string
+		csv           string
+		wantErr       bool
+		wantImported  int
+		wantUnmatched []string
+		wantHoldings  map[string]float64
+		wantCostPrice map[string]float64
+	}{
+		{
+			name:          "valid rows",
+			csv:           "Bitcoin,1.5,30000\nETH,2\n",
+			wantImported:  2,
+			wantHoldings:  map[string]float64{"bitcoin": 1.5, "ethereum": 2},
+			wantCostPrice: map[string]float64{"bitcoin": 20000},
+		},
+		{
+			name:         "header",
+			csv:          "coin,holdings,cost_basis\nBitcoin,1,\n",
+			wantImported: 1,
+			wantHoldings: map[string]float64{"bitcoin": 1},
+		},
+		{
+			name:    "bad holdings",
+			csv:     "Bitcoin,1\nEthereum,lots\n",
+			wantErr: true,
+		},
+		{
+			name:    "bad cost basis",
+			csv:     "Bitcoin,1,free\n",
+			wantErr: true,
+		},
+		{
+			name:    "too many fields",
+			csv:     "Bitcoin,1,2,3\n",
+			wantErr: true,
+		},
+		{
+			name:         "duplicate coins",
+			csv:          "Bitcoin,1,10000\nbtc,2\n",
+			wantImported: 2,
+			wantHoldings: map[string]float64{"bitcoin": 2},
+		},
+		{
+			name:          "unknown coin",
+			csv:           "Bitcoin,1\nNot A Coin,5\n",
+			wantImported:  1,
+			wantUnmatched: []string{"Not A Coin"},
+			wantHoldings:  map[string]float64{"bitcoin": 1},
+		},
+	}
+	for _, tt := range tests {
+		ct := &Cointop{
+			State: &State{
+				currencyConversion: "USD",
+				allCoins: []*Coin{
+					{Name: "Bitcoin", ID: "bitcoin", Symbol: "BTC", Rank: 1},
+					{Name: "Ethereum", ID: "ethereum", Symbol: "ETH", Rank: 2},
+				},
+				portfolio: &Portfolio{Entries: map[string]*PortfolioEntry{}},
+			},
+			api:      api.NewDemo(),
+			demoMode: true,
+		}
+
+		result, err := ct.ImportPortfolio(strings.NewReader(tt.csv))
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: expected an error", tt.name)
+			}
+			if len(ct.State.portfolio.Entries) != 0 {
+				t.Errorf("%s: entries == %v, want none imported", tt.name, ct.State.portfolio.Entries)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %s", tt.name, err)
+			continue
+		}
+		if result.Imported != tt.wantImported {
+			t.Errorf("%s: imported == %d, want %d", tt.name, result.Imported, tt.wantImported)
+		}
+		if !reflect.DeepEqual(result.Unmatched, tt.wantUnmatched) {
+			t.Errorf("%s: unmatched == %v, want %v", tt.name, result.Unmatched, tt.wantUnmatched)
+		}
+		holdings := make(map[string]float64)
+		costPrice := make(map[string]float64)
+		for key, entry := range ct.State.portfolio.Entries {
+			holdings[key] = entry.Holdings
+			for _, lot := range entry.Lots {
+				costPrice[key] += lot.Price
+			}
+		}
+		if tt.wantCostPrice == nil {
+			tt.wantCostPrice = map[string]float64{}
+		}
+		if !reflect.DeepEqual(holdings, tt.wantHoldings) {
+			t.Errorf("%s: holdings == %v, want %v", tt.name, holdings, tt.wantHoldings)
+		}
+		if !reflect.DeepEqual(costPrice, tt.wantCostPrice) {
+			t.Errorf("%s: cost prices == %v, want %v", tt.name, costPrice, tt.wantCostPrice)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PortfolioLotDateFormat is the date format used for portfolio lots
+const PortfolioLotDateFormat = "2006-01-02"
+
+// PortfolioLot is a single buy of a portfolio coin
+type PortfolioLot struct {
+	Amount float64
+	// price paid per coin, zero if unknown
+	Price float64
+	Date  int64
+}
+
+// LotsHoldings returns the total amount of the lots
+func LotsHoldings(lots []*PortfolioLot) float64 {
+	var holdings float64
+	for _, lot := range lots {
+		holdings += lot.Amount
+	}
+	return holdings
+}
+
+// LotsCost returns the total cost and the amount of the lots that have a known price
+func LotsCost(lots []*PortfolioLot) (cost float64, amount float64) {
+	for _, lot := range lots {
+		if lot.Price <= 0 {
+			continue
+		}
+		cost += lot.Amount * lot.Price
+		amount += lot.Amount
+	}
+	return cost, amount
+}
+
+// LotsAverageCost returns the weighted average cost per coin of the lots that have a known price
+func LotsAverageCost(lots []*PortfolioLot) float64 {
+	cost, amount := LotsCost(lots)
+	if amount == 0 {
+		return 0
+	}
+	return cost / amount
+}
+
+// portfolioEntryByName returns the portfolio entry of the coin name, adding an empty one if there's none.
+// The coins are keyed by name so an entry saved under the symbol is found through the loaded coin
+func (ct *Cointop) portfolioEntryByName(name string) *PortfolioEntry {
+	if ic, ok := ct.State.allCoinsSlugMap.Load(name); ok {
+		if c, _ := ic.(*Coin); c != nil {
+			if p, isNew := ct.PortfolioEntry(c); !isNew {
+				return p
+			}
+		}
+	}
+
+	key := strings.ToLower(name)
+	p, ok := ct.State.portfolio.Entries[key]
+	if !ok {
+		p = &PortfolioEntry{
+			Coin: name,
+		}
+		ct.State.portfolio.Entries[key] = p
+	}
+	return p
+}
+
+// AddPortfolioLot adds a buy lot to a portfolio entry and sets the entry holdings from its lots
+func (ct *Cointop) AddPortfolioLot(coin string, lot *PortfolioLot) error {
+	ct.debuglog("addPortfolioLot()")
+	p := ct.portfolioEntryByName(coin)
+
+	// NOTE: holdings entered before using lots are kept as a lot with unknown price
+	if len(p.Lots) == 0 && p.Holdings > 0 {
+		p.Lots = append(p.Lots, &PortfolioLot{
+			Amount: p.Holdings,
+		})
+	}
+
+	p.Lots = append(p.Lots, lot)
+	p.Holdings = LotsHoldings(p.Lots)
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetPortfolioCostBasis sets the holdings of a portfolio entry bought at an average price per coin.
+// The lots of the entry are replaced by a single lot of the holdings
+func (ct *Cointop) SetPortfolioCostBasis(coin string, lot *PortfolioLot) error {
+	ct.debuglog("setPortfolioCostBasis()")
+	p := ct.portfolioEntryByName(coin)
+	p.Holdings = lot.Amount
+	p.Lots = []*PortfolioLot{lot}
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ParsePortfolioLot parses a lot from input in the form "+amount@price[@YYYY-MM-DD]"
+func ParsePortfolioLot(input string) (*PortfolioLot, error) {
+	input = strings.TrimPrefix(strings.TrimSpace(input), "+")
+	parts := strings.Split(input, "@")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("invalid lot %q. Expected +amount@price[@YYYY-MM-DD]", input)
+	}
+
+	amount, err := strconv.ParseFloat(normalizeFloatString(parts[0]), 64)
+	if err != nil {
+		return nil, err
+	}
+	price, err := strconv.ParseFloat(normalizeFloatString(parts[1]), 64)
+	if err != nil {
+		return nil, err
+	}
+
+	date := time.Now().Unix()
+	if len(parts) == 3 {
+		t, err := time.Parse(PortfolioLotDateFormat, strings.TrimSpace(parts[2]))
+		if err != nil {
+			return nil, err
+		}
+		date = t.Unix()
+	}
+
+	return &PortfolioLot{
+		Amount: amount,
+		Price:  price,
+		Date:   date,
+	}, nil
+}
+
+// portfolioLotsToToml returns the portfolio lots as config tuples
+func (ct *Cointop) portfolioLotsToToml() [][]string {
+	var lotsIfc [][]string
+	for _, entry := range ct.State.portfolio.Entries {
+		if entry.Coin == "" {
+			continue
+		}
+		for _, lot := range entry.Lots {
+			var date string
+			if lot.Date > 0 {
+				date = time.Unix(lot.Date, 0).UTC().Format(PortfolioLotDateFormat)
+			}
+			lotsIfc = append(lotsIfc, []string{
+				entry.Coin,
+				strconv.FormatFloat(lot.Amount, 'f', -1, 64),
+				strconv.FormatFloat(lot.Price, 'f', -1, 64),
+				date,
+			})
+		}
+	}
+	sort.SliceStable(lotsIfc, func(i, j int) bool {
+		if lotsIfc[i][0] != lotsIfc[j][0] {
+			return lotsIfc[i][0] < lotsIfc[j][0]
+		}
+		return lotsIfc[i][3] < lotsIfc[j][3]
+	})
+	return lotsIfc
+}
+
+// loadPortfolioLotsFromConfig loads the portfolio lots config tuples into the portfolio entries
+func (ct *Cointop) loadPortfolioLotsFromConfig(valueIfc interface{}) error {
+	ct.debuglog("loadPortfolioLotsFromConfig()")
+	lotsIfc, ok := valueIfc.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, itemIfc := range lotsIfc {
+		tupleIfc, ok := itemIfc.([]interface{})
+		if !ok || len(tupleIfc) != 4 {
+			continue
+		}
+		name, ok := tupleIfc[0].(string)
+		if !ok {
+			continue
+		}
+		amount, err := ct.InterfaceToFloat64(tupleIfc[1])
+		if err != nil {
+			return err
+		}
+		price, err := ct.InterfaceToFloat64(tupleIfc[2])
+		if err != nil {
+			return err
+		}
+		var date int64
+		if s, ok := tupleIfc[3].(string); ok && s != "" {
+			t, err := time.Parse(PortfolioLotDateFormat, s)
+			if err != nil {
+				return err
+			}
+			date = t.Unix()
+		}
+
+		key := strings.ToLower(name)
+		p, ok := ct.State.portfolio.Entries[key]
+		if !ok {
+			p = &PortfolioEntry{
+				Coin: name,
+			}
+			ct.State.portfolio.Entries[key] = p
+		}
+		p.Lots = append(p.Lots, &PortfolioLot{
+			Amount: amount,
+			Price:  price,
+			Date:   date,
+		})
+		p.Holdings = LotsHoldings(p.Lots)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package cointop
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/cache"
+	"github.com/miguelmota/cointop/pkg/humanize"
+)
+
+// TestGetPortfolioChange24H checks that the percent change is the change of the portfolio value since its value 24h ago
+func TestGetPortfolioChange24H(t *testing.T) {
+	ct := &Cointop{State: &State{
+		currencyConversion: "USD",
+		allCoins: []*Coin{
+			{Name: "Bitcoin", Symbol: "BTC", Price: 110, PercentChange24H: 10},
+			{Name: "Ethereum", Symbol: "ETH", Price: 40, PercentChange24H: -20},
+			{Name: "Dogecoin", Symbol: "DOGE", Price: 1, PercentChange24H: 50},
+		},
+		portfolio: &Portfolio{Entries: map[string]*PortfolioEntry{
+			"bitcoin":  {Coin: "Bitcoin", Holdings: 1},
+			"ethereum": {Coin: "Ethereum", Holdings: 0.5},
+		}},
+	}}
+
+	// bitcoin went from 100 to 110 and ethereum from 25 to 20, so the portfolio went from 125 to 130
+	change, percentChange := ct.GetPortfolioChange24H()
+	if math.Abs(change-5) > 1e-9 {
+		t.Errorf("change == %v, want 5", change)
+	}
+	if math.Abs(percentChange-4) > 1e-9 {
+		t.Errorf("percent change == %v, want 4", percentChange)
+	}
+
+	ct.State.portfolio.Entries = map[string]*PortfolioEntry{}
+	if change, percentChange := ct.GetPortfolioChange24H(); change != 0 || percentChange != 0 {
+		t.Errorf("change == %v, %v, want 0, 0 for an empty portfolio", change, percentChange)
+	}
+}
+
+// TestPortfolioRatePending checks that the values wait on the rate to the portfolio currency instead of using the
+// table currency
+func TestPortfolioRatePending(t *testing.T) {
+	ct := &Cointop{State: &State{
+		currencyConversion: "USD",
+		portfolioCurrency:  "EUR",
+		allCoins: []*Coin{
+			{Name: "Bitcoin", Symbol: "BTC", Price: 100},
+		},
+		portfolio: &Portfolio{Entries: map[string]*PortfolioEntry{
+			"bitcoin": {Coin: "Bitcoin", Holdings: 2, Lots: []*PortfolioLot{{Amount: 2, Price: 80}}},
+		}},
+	}}
+
+	if !ct.IsPortfolioRatePending() {
+		t.Fatal("expected the rate to be pending")
+	}
+	coin := ct.GetPortfolioSlice()[0]
+	if coin.Balance != 0 || coin.ProfitLoss != 0 {
+		t.Errorf("balance, pnl == %v, %v, want 0, 0 while the rate is pending", coin.Balance, coin.ProfitLoss)
+	}
+
+	ct.State.priceCurrencyRates.Store(priceCurrencyRateKey("USD", "EUR"), 0.9)
+	coin = ct.GetPortfolioSlice()[0]
+	if coin.Balance != 180 || coin.ProfitLoss != 20 {
+		t.Errorf("balance, pnl == %v, %v, want 180, 20 in EUR", coin.Balance, coin.ProfitLoss)
+	}
+}
+
+// TestFormatHoldings checks that the holdings are rounded by the precision and use the configured separators
+func TestFormatHoldings(t *testing.T) {
+	humanize.SetSeparators(".", ",")
+	defer humanize.SetSeparators(humanize.DefaultThousandsSeparator, humanize.DefaultDecimalSeparator)
+
+	tests := []struct {
+		precision int
+		holdings  float64
+		want      string
+	}{
+		{HoldingsPrecisionFull, 1234.5678, "1.234,5678"},
+		{2, 1234.5678, "1.234,57"},
+		{0, 1234.5678, "1.235"},
+		{HoldingsPrecisionAuto, 1234.5678, "1.234,57"},
+		{HoldingsPrecisionAuto, 1.5, "1,5"},
+		{HoldingsPrecisionAuto, 1234567, "1.234.567"},
+		{HoldingsPrecisionAuto, 0, "0"},
+	}
+	for _, tt := range tests {
+		ct := &Cointop{State: &State{holdingsPrecision: tt.precision}}
+		if got := ct.FormatHoldings(tt.holdings); got != tt.want {
+			t.Errorf("precision %d: holdings %v == %q, want %q", tt.precision, tt.holdings, got, tt.want)
+		}
+	}
+}
+
+// TestPortfolioLotsEntry checks that the lots are set on the entry of the coin name, including entries saved under the
+// symbol
+func TestPortfolioLotsEntry(t *testing.T) {
+	ct := &Cointop{
+		demoMode: true,
+		cache:    cache.New(time.Minute, time.Minute),
+		State: &State{
+			portfolio: &Portfolio{Entries: map[string]*PortfolioEntry{
+				"btc": {Coin: "BTC", Holdings: 1},
+			}},
+		},
+	}
+	ct.State.allCoinsSlugMap.Store("Bitcoin", &Coin{Name: "Bitcoin", Symbol: "BTC"})
+
+	if err := ct.SetPortfolioCostBasis("Bitcoin", &PortfolioLot{Amount: 2, Price: 100}); err != nil {
+		t.Fatal(err)
+	}
+	if len(ct.State.portfolio.Entries) != 1 {
+		t.Fatalf("entries == %v, want only the symbol entry", ct.State.portfolio.Entries)
+	}
+	if p := ct.State.portfolio.Entries["btc"]; p.Holdings != 2 || len(p.Lots) != 1 {
+		t.Errorf("holdings, lots == %v, %v, want 2, 1", p.Holdings, len(p.Lots))
+	}
+
+	if err := ct.AddPortfolioLot("Ethereum", &PortfolioLot{Amount: 3, Price: 10}); err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := ct.State.portfolio.Entries["ethereum"]; !ok || p.Coin != "Ethereum" || p.Holdings != 3 {
+		t.Errorf("entry == %+v, want the new Ethereum entry with holdings 3", p)
+	}
+}
@@ -2,12 +2,18 @@ package cointop
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/miguelmota/cointop/pkg/api"
+	apitypes "github.com/miguelmota/cointop/pkg/api/types"
 	"github.com/miguelmota/cointop/pkg/humanize"
 )
 
+// WatchPriceNA is printed in place of the price of a coin that isn't found
+const WatchPriceNA = "NA"
+
 // PriceConfig is the config options for the coin price method
 type PriceConfig struct {
 	Coin      string
@@ -22,6 +28,15 @@ type PricesConfig struct {
 	APIChoice string
 }
 
+// WatchConfig is the config options for the watch prices method
+type WatchConfig struct {
+	Coins     []string
+	Currency  string
+	APIChoice string
+	Interval  time.Duration
+	Count     int
+}
+
 // PrintPrices outputs the current price of the coins
 func PrintPrices(config *PricesConfig) error {
 	prices, err := GetCoinPrices(config)
@@ -47,18 +62,36 @@ func PrintPrice(config *PriceConfig) error {
 	return nil
 }
 
+// PrintPrice outputs the current price of the coin using the configured API.
+// An empty convert value uses the configured currency
+func (ct *Cointop) PrintPrice(name string, convert string) error {
+	ct.debuglog("printPrice()")
+	if strings.TrimSpace(name) == "" {
+		return ErrCoinNameOrSymbolRequired
+	}
+	if convert == "" {
+		convert = ct.State.currencyConversion
+	}
+	convert = strings.ToUpper(convert)
+
+	price, err := ct.API().Price(name, convert)
+	if err != nil {
+		return fmt.Errorf("failed to get the price of %q: %w", name, err)
+	}
+
+	symbol := CurrencySymbol(convert)
+	fmt.Printf("%s%s\n", symbol, humanize.Commaf(price))
+	return nil
+}
+
 // GetCoinPrices returns the current price of the specified coins
 func GetCoinPrices(config *PricesConfig) ([]string, error) {
 	if len(config.Coins) == 0 {
 		return nil, ErrCoinNameOrSymbolRequired
 	}
-	var priceAPI api.Interface
-	if config.APIChoice == CoinMarketCap {
-		priceAPI = api.NewCMC("")
-	} else if config.APIChoice == CoinGecko {
-		priceAPI = api.NewCG()
-	} else {
-		return nil, ErrInvalidAPIChoice
+	priceAPI, err := newPriceAPI(config.APIChoice)
+	if err != nil {
+		return nil, err
 	}
 
 	var prices []string
@@ -75,3 +108,74 @@ func GetCoinPrices(config *PricesConfig) ([]string, error) {
 
 	return prices, nil
 }
+
+// newPriceAPI returns the API client for the API choice
+func newPriceAPI(apiChoice string) (api.Interface, error) {
+	switch apiChoice {
+	case CoinMarketCap:
+		return api.NewCMC("", ""), nil
+	case CoinGecko:
+		return api.NewCG(false, 0, nil, 0), nil
+	case CoinPaprika:
+		return api.NewCP(), nil
+	default:
+		return nil, ErrInvalidAPIChoice
+	}
+}
+
+// WatchPrices prints the current prices of the coins as a tab separated line at every interval.
+// The prices are in the order of the coins and a coin that isn't found is printed as NA
+func WatchPrices(config *WatchConfig) error {
+	if len(config.Coins) == 0 {
+		return ErrCoinNameOrSymbolRequired
+	}
+	priceAPI, err := newPriceAPI(config.APIChoice)
+	if err != nil {
+		return err
+	}
+	interval := config.Interval
+	if interval <= 0 {
+		interval = 60 * time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 0; config.Count <= 0 || i < config.Count; i++ {
+		if i > 0 {
+			<-ticker.C
+		}
+		coins, err := priceAPI.GetCoinDataBatch(config.Coins, config.Currency)
+		if err != nil {
+			// NOTE: a failed fetch prints NA for every coin so the watch keeps going
+			coins = nil
+		}
+		fmt.Println(strings.Join(WatchPriceLine(config.Coins, coins), "\t"))
+	}
+
+	return nil
+}
+
+// WatchPriceLine returns the prices of the coins in the order given, matching each coin by ID, name or symbol.
+// A symbol shared by several coins matches the highest ranked one and a coin that isn't found is NA
+func WatchPriceLine(names []string, coins []apitypes.Coin) []string {
+	prices := make([]string, len(names))
+	for i, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		var match *apitypes.Coin
+		for j := range coins {
+			coin := &coins[j]
+			if strings.ToLower(coin.ID) == name || strings.ToLower(coin.Name) == name {
+				match = coin
+				break
+			}
+			if strings.ToLower(coin.Symbol) == name && (match == nil || coin.Rank < match.Rank) {
+				match = coin
+			}
+		}
+		prices[i] = WatchPriceNA
+		if match != nil {
+			prices[i] = strconv.FormatFloat(match.Price, 'f', -1, 64)
+		}
+	}
+	return prices
+}
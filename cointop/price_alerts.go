@@ -34,6 +34,9 @@ var PriceAlertOperatorMap = map[string]string{
 	"=":  "=",
 }
 
+// PriceAlertCooldown is the minimum time between notifications of a reoccurring price or portfolio alert
+const PriceAlertCooldown = 1 * time.Hour
+
 // PriceAlertFrequencyMap is map of valid price alert frequency values
 var PriceAlertFrequencyMap = map[string]bool{
 	"once":        true,
@@ -107,7 +110,7 @@ func (ct *Cointop) GetPriceAlertsTable() *table.Table {
 					Text:        targetPrice,
 				})
 			case "price":
-				text := humanize.Commaf(coin.Price)
+				text := ct.FormatPrice(coin.Price)
 				ct.SetTableColumnWidthFromString(header, text)
 				ct.SetTableColumnAlignLeft(header, false)
 				rowCells = append(rowCells, &table.RowCell{
@@ -133,6 +136,7 @@ func (ct *Cointop) GetPriceAlertsTable() *table.Table {
 		rows = append(rows, rowCells)
 	}
 
+	ct.ResolveTableColumnWidths(headers)
 	for _, row := range rows {
 		for i, header := range headers {
 			row[i].Width = ct.GetTableColumnWidth(header)
@@ -169,6 +173,11 @@ func (ct *Cointop) PriceAlertWatcher() error {
 				return err
 			}
 		}
+		for _, alert := range ct.State.priceAlerts.Portfolio {
+			if err := ct.CheckPortfolioAlert(alert); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -179,6 +188,9 @@ func (ct *Cointop) CheckPriceAlert(alert *PriceAlert) error {
 	if alert.Expired {
 		return nil
 	}
+	if alert.Frequency == "reoccurring" && time.Since(alert.LastTriggered) < PriceAlertCooldown {
+		return nil
+	}
 
 	coinIfc, _ := ct.State.allCoinsSlugMap.Load(alert.CoinName)
 	coin, ok := coinIfc.(*Coin)
@@ -211,13 +223,21 @@ func (ct *Cointop) CheckPriceAlert(alert *PriceAlert) error {
 	}
 
 	if msg != "" {
-		if ct.State.priceAlerts.SoundEnabled {
-			notifier.Notify(title, msg)
-		} else {
-			notifier.Notify(title, msg)
+		ct.notifyAlert(title, msg, &PriceAlertWebhookPayload{
+			Text:        msg,
+			Content:     msg,
+			CoinName:    alert.CoinName,
+			Symbol:      coin.Symbol,
+			Operator:    alert.Operator,
+			TargetPrice: alert.TargetPrice,
+			Price:       coin.Price,
+			Currency:    ct.State.currencyConversion,
+			Frequency:   alert.Frequency,
+		})
+		alert.LastTriggered = time.Now()
+		if alert.Frequency == "once" {
+			alert.Expired = true
 		}
-
-		alert.Expired = true
 	}
 
 	if err := ct.Save(); err != nil {
@@ -226,6 +246,49 @@ func (ct *Cointop) CheckPriceAlert(alert *PriceAlert) error {
 	return nil
 }
 
+// notifyAlert shows a desktop notification of a triggered alert, plays the alert sound if enabled and posts the payload
+// to the webhook if set. The sound is played instead when the notification can't be shown, eg. the notification
+// command isn't installed
+func (ct *Cointop) notifyAlert(title string, msg string, payload interface{}) {
+	notified := false
+	if ct.State.priceAlerts.NotifyDesktop {
+		if err := notifier.Notify(title, msg); err != nil {
+			ct.debuglog(fmt.Sprintf("notify: %v", err))
+		} else {
+			notified = true
+		}
+	}
+	if ct.State.priceAlerts.SoundEnabled || (ct.State.priceAlerts.NotifyDesktop && !notified) {
+		notifier.Beep()
+	}
+	if ct.State.priceAlerts.Webhook != "" {
+		go ct.postAlertWebhook(payload)
+	}
+}
+
+// PriceAlertWebhookPayload is the JSON posted to the webhook when a price alert triggers. The text and content
+// fields hold the alert message so the payload can be posted to Slack and Discord webhooks as is
+type PriceAlertWebhookPayload struct {
+	Text        string  `json:"text"`
+	Content     string  `json:"content"`
+	CoinName    string  `json:"coin_name"`
+	Symbol      string  `json:"symbol"`
+	Operator    string  `json:"operator"`
+	TargetPrice float64 `json:"target_price"`
+	Price       float64 `json:"price"`
+	Currency    string  `json:"currency"`
+	Frequency   string  `json:"frequency"`
+}
+
+// postAlertWebhook posts the triggered alert to the webhook. A failed request is only logged so the alert watcher
+// keeps running
+func (ct *Cointop) postAlertWebhook(payload interface{}) {
+	ct.debuglog("postAlertWebhook()")
+	if err := notifier.PostWebhook(ct.State.priceAlerts.Webhook, payload); err != nil {
+		ct.debuglog(fmt.Sprintf("webhook: %v", err))
+	}
+}
+
 // UpdatePriceAlertsUpdateMenu updates the alerts update menu view
 func (ct *Cointop) UpdatePriceAlertsUpdateMenu(isNew bool) error {
 	ct.debuglog("updatePriceAlertsUpdateMenu()")
@@ -328,6 +391,21 @@ func (ct *Cointop) HidePriceAlertsUpdateMenu() error {
 
 // EnterKeyPressHandler is the key press handle for update menus
 func (ct *Cointop) EnterKeyPressHandler() error {
+	if ct.State.perPageMenuVisible {
+		return ct.SetPerPageFromInput()
+	}
+	if ct.State.goToRankMenuVisible {
+		return ct.GoToRankFromInput()
+	}
+	if ct.State.favoriteGroupMenuVisible {
+		return ct.SetFavoriteGroupFromInput()
+	}
+	if ct.State.filterMenuVisible {
+		return ct.SetFilterFromInput()
+	}
+	if ct.State.marketCapMenuVisible {
+		return ct.SetMarketCapRangeFromInput()
+	}
 	if ct.IsPriceAlertsVisible() {
 		return ct.CreatePriceAlert()
 	}
@@ -335,6 +413,27 @@ func (ct *Cointop) EnterKeyPressHandler() error {
 	return ct.SetPortfolioHoldings()
 }
 
+// EscKeyPressHandler is the escape key press handle for update menus
+func (ct *Cointop) EscKeyPressHandler() error {
+	if ct.State.perPageMenuVisible {
+		return ct.HidePerPageMenu()
+	}
+	if ct.State.goToRankMenuVisible {
+		return ct.HideGoToRankMenu()
+	}
+	if ct.State.favoriteGroupMenuVisible {
+		return ct.HideFavoriteGroupMenu()
+	}
+	if ct.State.filterMenuVisible {
+		return ct.HideFilterMenu()
+	}
+	if ct.State.marketCapMenuVisible {
+		return ct.HideMarketCapMenu()
+	}
+
+	return ct.HidePortfolioUpdateMenu()
+}
+
 // CreatePriceAlert sets price from inputed value
 func (ct *Cointop) CreatePriceAlert() error {
 	ct.debuglog("createPriceAlert()")
@@ -0,0 +1,124 @@
+package cointop
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+// TestPriceAlertWebhookPayload checks the JSON fields posted to the webhook
+func TestPriceAlertWebhookPayload(t *testing.T) {
+	b, err := json.Marshal(&PriceAlertWebhookPayload{
+		Text:        "Bitcoin price is greater than $50,000",
+		Content:     "Bitcoin price is greater than $50,000",
+		CoinName:    "Bitcoin",
+		Symbol:      "BTC",
+		Operator:    ">",
+		TargetPrice: 50000,
+		Price:       50100,
+		Currency:    "USD",
+		Frequency:   "once",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(b, &payload); err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for key := range payload {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	want := []string{"coin_name", "content", "currency", "frequency", "operator", "price", "symbol", "target_price", "text"}
+	if !reflect.DeepEqual(keys, want) {
+		t.Errorf("keys == %v, want %v", keys, want)
+	}
+	if payload["target_price"] != 50000.0 {
+		t.Errorf("target price == %v, want 50000", payload["target_price"])
+	}
+}
+
+// TestPriceAlertLastTriggered checks that the last triggered time of a price alert is saved to and loaded from the config
+func TestPriceAlertLastTriggered(t *testing.T) {
+	lastTriggered := time.Date(2021, 5, 1, 12, 30, 0, 0, time.UTC)
+	ct := &Cointop{State: &State{priceAlerts: &PriceAlerts{Entries: []*PriceAlert{
+		{CoinName: "Bitcoin", Operator: ">", TargetPrice: 50000, Frequency: "reoccurring", LastTriggered: lastTriggered},
+		{CoinName: "Ethereum", Operator: "<", TargetPrice: 1000, Frequency: "once"},
+	}}}}
+
+	// NOTE: the TOML decoder returns the tuples as slices of interfaces
+	var alertsIfc []interface{}
+	for _, tuple := range ct.priceAlertsToToml()["alerts"].([]interface{}) {
+		var tupleIfc []interface{}
+		for _, value := range tuple.([]string) {
+			tupleIfc = append(tupleIfc, value)
+		}
+		alertsIfc = append(alertsIfc, tupleIfc)
+	}
+	if n := len(alertsIfc[1].([]interface{})); n != 4 {
+		t.Errorf("tuple length == %d, want 4 for an alert that hasn't triggered", n)
+	}
+
+	loaded := &Cointop{State: &State{priceAlerts: &PriceAlerts{}}, config: config{
+		PriceAlerts: map[string]interface{}{"alerts": alertsIfc},
+	}}
+	if err := loaded.loadPriceAlertsFromConfig(); err != nil {
+		t.Fatal(err)
+	}
+	entries := loaded.State.priceAlerts.Entries
+	if len(entries) != 2 {
+		t.Fatalf("entries == %d, want 2", len(entries))
+	}
+	if !entries[0].LastTriggered.Equal(lastTriggered) {
+		t.Errorf("last triggered == %v, want %v", entries[0].LastTriggered, lastTriggered)
+	}
+	if !entries[1].LastTriggered.IsZero() {
+		t.Errorf("last triggered == %v, want zero", entries[1].LastTriggered)
+	}
+}
+
+// TestPortfolioAlertLastTriggered checks that the last triggered time of a portfolio alert is saved to and loaded from
+// the config
+func TestPortfolioAlertLastTriggered(t *testing.T) {
+	lastTriggered := time.Date(2021, 5, 1, 12, 30, 0, 0, time.UTC)
+	alert := NewPortfolioAlert(">=", 10, "reoccurring")
+	alert.LastTriggered = lastTriggered
+	ct := &Cointop{State: &State{priceAlerts: &PriceAlerts{Portfolio: []*PortfolioAlert{
+		alert,
+		NewPortfolioAlert("<=", -5, "once"),
+	}}}}
+
+	// NOTE: the TOML decoder returns the tuples as slices of interfaces
+	var alertsIfc []interface{}
+	for _, tuple := range ct.portfolioAlertsToToml() {
+		var tupleIfc []interface{}
+		for _, value := range tuple.([]string) {
+			tupleIfc = append(tupleIfc, value)
+		}
+		alertsIfc = append(alertsIfc, tupleIfc)
+	}
+	if n := len(alertsIfc[1].([]interface{})); n != 3 {
+		t.Errorf("tuple length == %d, want 3 for an alert that hasn't triggered", n)
+	}
+
+	loaded := &Cointop{State: &State{priceAlerts: &PriceAlerts{}}, config: config{
+		PriceAlerts: map[string]interface{}{"portfolio_alerts": alertsIfc},
+	}}
+	if err := loaded.loadPortfolioAlertsFromConfig(); err != nil {
+		t.Fatal(err)
+	}
+	alerts := loaded.State.priceAlerts.Portfolio
+	if len(alerts) != 2 {
+		t.Fatalf("alerts == %d, want 2", len(alerts))
+	}
+	if !alerts[0].LastTriggered.Equal(lastTriggered) {
+		t.Errorf("last triggered == %v, want %v", alerts[0].LastTriggered, lastTriggered)
+	}
+	if !alerts[1].LastTriggered.IsZero() {
+		t.Errorf("last triggered == %v, want zero", alerts[1].LastTriggered)
+	}
+}
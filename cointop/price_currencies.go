@@ -0,0 +1,178 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PriceCurrencyColumnPrefix is the prefix of the price columns of the extra price currencies
+const PriceCurrencyColumnPrefix = "price_"
+
+// priceCurrencyRateCoin is the coin whose prices give the cross rates of the price currencies
+const priceCurrencyRateCoin = "bitcoin"
+
+// PriceCurrencyColumn returns the column name of the price in the currency
+func PriceCurrencyColumn(currency string) string {
+	return PriceCurrencyColumnPrefix + strings.ToLower(currency)
+}
+
+// priceCurrencyFromColumn returns the currency of a price currency column
+func priceCurrencyFromColumn(col string) (string, bool) {
+	if !strings.HasPrefix(col, PriceCurrencyColumnPrefix) || len(col) == len(PriceCurrencyColumnPrefix) {
+		return "", false
+	}
+	return strings.ToUpper(strings.TrimPrefix(col, PriceCurrencyColumnPrefix)), true
+}
+
+// headerColumn returns the header column of the column name, including the price currency columns
+func headerColumn(col string) (*HeaderColumn, bool) {
+	if hc, ok := HeaderColumns[col]; ok {
+		return hc, true
+	}
+	if currency, ok := priceCurrencyFromColumn(col); ok {
+		label := fmt.Sprintf("%sprice %s", CurrencySymbol(currency), currency)
+		return &HeaderColumn{
+			Slug:       col,
+			Label:      label,
+			PlainLabel: label,
+		}, true
+	}
+	return nil, false
+}
+
+// priceCurrencies returns the configured price currencies other than the currency conversion
+func (ct *Cointop) priceCurrencies() []string {
+	var currencies []string
+	for _, currency := range ct.State.priceCurrencies {
+		if currency != ct.State.currencyConversion {
+			currencies = append(currencies, currency)
+		}
+	}
+	return currencies
+}
+
+// withPriceCurrencyColumns returns the columns with a price column for each price currency after the price column
+func (ct *Cointop) withPriceCurrencyColumns(columns []string) []string {
+	currencies := ct.priceCurrencies()
+	if len(currencies) == 0 {
+		return columns
+	}
+
+	ret := make([]string, 0, len(columns)+len(currencies))
+	for _, col := range columns {
+		ret = append(ret, col)
+		if col == "price" {
+			for _, currency := range currencies {
+				ret = append(ret, PriceCurrencyColumn(currency))
+			}
+		}
+	}
+	return ret
+}
+
+// PriceIn returns the coin price in the currency using the cross rate from the currency conversion.
+// It returns false when the rate isn't fetched yet
+func (ct *Cointop) PriceIn(coin *Coin, currency string) (float64, bool) {
+	rate, ok := ct.priceCurrencyRate(currency)
+	if !ok {
+		return 0, false
+	}
+	return coin.Price * rate, true
+}
+
+// priceCurrencyRate returns the cross rate from the currency conversion to the currency.
+// It returns false when the rate isn't fetched yet
+func (ct *Cointop) priceCurrencyRate(currency string) (float64, bool) {
+	if currency == ct.State.currencyConversion {
+		return 1, true
+	}
+	rateIfc, ok := ct.State.priceCurrencyRates.Load(priceCurrencyRateKey(ct.State.currencyConversion, currency))
+	if !ok {
+		return 0, false
+	}
+	return rateIfc.(float64), true
+}
+
+// rateCurrencies returns the currencies to fetch the cross rates of, which are the price currencies and the
+// portfolio currency
+func (ct *Cointop) rateCurrencies() []string {
+	currencies := ct.priceCurrencies()
+	portfolioCurrency := ct.State.portfolioCurrency
+	if portfolioCurrency == "" || portfolioCurrency == ct.State.currencyConversion {
+		return currencies
+	}
+	for _, currency := range currencies {
+		if currency == portfolioCurrency {
+			return currencies
+		}
+	}
+	return append(currencies, portfolioCurrency)
+}
+
+// priceCurrencyRateKey returns the key of the cross rate between the currencies
+func priceCurrencyRateKey(from string, to string) string {
+	return fmt.Sprintf("%s_%s", from, to)
+}
+
+// updatePriceCurrencyRates fetches the cross rates and updates the table with them
+func (ct *Cointop) updatePriceCurrencyRates() error {
+	ct.debuglog("updatePriceCurrencyRates()")
+	if err := ct.fetchPriceCurrencyRates(); err != nil {
+		return err
+	}
+
+	go ct.UpdateTable()
+	return nil
+}
+
+// fetchPriceCurrencyRates fetches the cross rates from the currency conversion to the price currencies and the
+// portfolio currency.
+// The APIs only convert to one currency at a time so the rates are derived from the prices of a single coin
+func (ct *Cointop) fetchPriceCurrencyRates() error {
+	currencies := ct.rateCurrencies()
+	if len(currencies) == 0 || ct.IsOffline() {
+		return nil
+	}
+
+	convert := ct.State.currencyConversion
+	base, err := ct.API().Price(priceCurrencyRateCoin, convert)
+	if err != nil {
+		return err
+	}
+	if base <= 0 {
+		return nil
+	}
+
+	for _, currency := range currencies {
+		price, err := ct.API().Price(priceCurrencyRateCoin, currency)
+		if err != nil {
+			ct.State.priceCurrencyRates.Delete(priceCurrencyRateKey(convert, currency))
+			continue
+		}
+		// NOTE: the rates are keyed by the currency conversion so rates from before a currency change aren't used
+		ct.State.priceCurrencyRates.Store(priceCurrencyRateKey(convert, currency), price/base)
+	}
+
+	return nil
+}
+
+// loadPriceCurrenciesFromConfig loads the price currencies from the table config to struct
+func (ct *Cointop) loadPriceCurrenciesFromConfig() error {
+	ifcs, ok := ct.config.Table["price_currencies"].([]interface{})
+	if !ok {
+		return nil
+	}
+	var currencies []string
+	for _, ifc := range ifcs {
+		v, ok := ifc.(string)
+		if !ok {
+			return fmt.Errorf("invalid price_currencies value %v", ifc)
+		}
+		v = strings.ToUpper(strings.TrimSpace(v))
+		if v != "" {
+			currencies = append(currencies, v)
+		}
+	}
+	ct.State.priceCurrencies = currencies
+	return nil
+}
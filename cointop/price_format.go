@@ -0,0 +1,126 @@
+package cointop
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/humanize"
+)
+
+// PricePrecisionFull displays prices with the precision given by the API
+const PricePrecisionFull = -1
+
+// PricePrecisionAuto displays prices rounded with the price rounding rules
+const PricePrecisionAuto = -2
+
+// DefaultPriceSignificantDigits is the number of significant digits of prices below the smallest price rounding rule
+const DefaultPriceSignificantDigits = 4
+
+// PriceRoundingRule is the number of decimal places of prices at or above a magnitude
+type PriceRoundingRule struct {
+	Min      float64
+	Decimals int
+}
+
+// DefaultPriceRoundingRules returns the default price rounding rules
+func DefaultPriceRoundingRules() []*PriceRoundingRule {
+	return []*PriceRoundingRule{
+		{Min: 1000, Decimals: 2},
+		{Min: 1, Decimals: 4},
+		{Min: 0.01, Decimals: 6},
+	}
+}
+
+// FormatPrice returns the price with commas and rounded with the configured price precision
+func (ct *Cointop) FormatPrice(price float64) string {
+	switch precision := ct.State.pricePrecision; {
+	case precision == PricePrecisionAuto:
+		return humanize.Commafd(price, PriceDecimals(price, ct.State.priceRoundingRules, ct.State.priceSigDigits))
+	case precision >= 0:
+		return humanize.Commafd(price, precision)
+	default:
+		return humanize.Commaf(price)
+	}
+}
+
+// PriceDecimals returns the number of decimal places for the price using the first rule the price is at or above.
+// Prices below every rule are rounded to the number of significant digits
+func PriceDecimals(price float64, rules []*PriceRoundingRule, significantDigits int) int {
+	abs := math.Abs(price)
+	for _, rule := range rules {
+		if abs >= rule.Min {
+			return rule.Decimals
+		}
+	}
+	if abs == 0 || math.IsNaN(abs) || math.IsInf(abs, 0) {
+		return 0
+	}
+	decimals := significantDigits - int(math.Floor(math.Log10(abs))) - 1
+	if decimals < 0 {
+		return 0
+	}
+	return decimals
+}
+
+// priceRoundingRulesToToml returns the price rounding rules as config tuples
+func (ct *Cointop) priceRoundingRulesToToml() [][]string {
+	var rulesIfc [][]string
+	for _, rule := range ct.State.priceRoundingRules {
+		rulesIfc = append(rulesIfc, []string{strconv.FormatFloat(rule.Min, 'f', -1, 64), strconv.Itoa(rule.Decimals)})
+	}
+	return rulesIfc
+}
+
+// loadPriceRoundingFromConfig loads the price precision and rounding rules from the table config
+func (ct *Cointop) loadPriceRoundingFromConfig() error {
+	ct.debuglog("loadPriceRoundingFromConfig()")
+	if valueIfc, ok := ct.config.Table["price_precision"]; ok {
+		if v, ok := valueIfc.(string); ok && v == "auto" {
+			ct.State.pricePrecision = PricePrecisionAuto
+		} else if v, ok := valueIfc.(int64); ok && v >= int64(PricePrecisionFull) {
+			ct.State.pricePrecision = int(v)
+		} else {
+			return fmt.Errorf("invalid price_precision %v. Valid values are \"auto\", -1 for full precision, or the number of decimal places", valueIfc)
+		}
+	}
+	if v, ok := ct.config.Table["price_significant_digits"].(int64); ok {
+		if v < 1 {
+			return fmt.Errorf("invalid price_significant_digits %d. Expected a number greater than 0", v)
+		}
+		ct.State.priceSigDigits = int(v)
+	}
+
+	rulesIfc, ok := ct.config.Table["price_rounding"].([]interface{})
+	if !ok {
+		return nil
+	}
+	var rules []*PriceRoundingRule
+	for _, itemIfc := range rulesIfc {
+		tupleIfc, ok := itemIfc.([]interface{})
+		if !ok || len(tupleIfc) != 2 {
+			continue
+		}
+		minStr, _ := tupleIfc[0].(string)
+		decimalsStr, _ := tupleIfc[1].(string)
+		min, err := strconv.ParseFloat(strings.TrimSpace(minStr), 64)
+		if err != nil || min < 0 {
+			return fmt.Errorf("invalid price_rounding price %q", minStr)
+		}
+		decimals, err := strconv.Atoi(strings.TrimSpace(decimalsStr))
+		if err != nil || decimals < 0 {
+			return fmt.Errorf("invalid price_rounding decimals %q", decimalsStr)
+		}
+		rules = append(rules, &PriceRoundingRule{
+			Min:      min,
+			Decimals: decimals,
+		})
+	}
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Min > rules[j].Min
+	})
+	ct.State.priceRoundingRules = rules
+	return nil
+}
@@ -0,0 +1,26 @@
+package cointop
+
+// PrivacyMask replaces the portfolio holdings and values while in privacy mode
+const PrivacyMask = "******"
+
+// MaskValue returns the privacy mask in place of the text while in privacy mode
+func (ct *Cointop) MaskValue(text string) string {
+	if ct.State.privacyMode && text != "" {
+		return PrivacyMask
+	}
+	return text
+}
+
+// TogglePrivacyMode toggles masking the portfolio holdings, values and totals
+func (ct *Cointop) TogglePrivacyMode() error {
+	ct.debuglog("togglePrivacyMode()")
+	ct.State.privacyMode = !ct.State.privacyMode
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go ct.UpdateTable()
+	go ct.UpdateMarketbar()
+	go ct.UpdateChart()
+	return nil
+}
@@ -0,0 +1,183 @@
+package cointop
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	types "github.com/miguelmota/cointop/pkg/api/types"
+)
+
+// RankChangeWindow is how far back the rank change column compares the rank to
+var RankChangeWindow = 24 * time.Hour
+
+// RankSnapshotInterval is the minimum time between two rank snapshots of a coin
+var RankSnapshotInterval = 1 * time.Hour
+
+// RankHistoryRetention is how long rank snapshots are kept
+var RankHistoryRetention = 48 * time.Hour
+
+// RankChangeDay compares the rank to the rank about 24 hours ago
+const RankChangeDay = "24h"
+
+// RankChangeRefresh compares the rank to the rank at the previous refresh
+const RankChangeRefresh = "refresh"
+
+var rankHistoryLock sync.Mutex
+
+// refreshRank is the rank of a coin at the latest refresh and at the refresh before it
+type refreshRank struct {
+	Rank       int
+	PrevRank   int
+	HasPrev    bool
+	Generation int
+}
+
+// RankSnapshot is the rank of a coin at a point in time
+type RankSnapshot struct {
+	Timestamp int64
+	Rank      int
+}
+
+// loadRankHistory reads the rank snapshots from the data directory
+func (ct *Cointop) loadRankHistory() {
+	rankHistoryLock.Lock()
+	defer rankHistoryLock.Unlock()
+	history := make(map[string][]RankSnapshot)
+	cachekey := ct.CacheKey("rankHistory")
+	if ct.datacache != nil {
+		ct.datacache.Get(cachekey, &history)
+	}
+	// NOTE: previous versions kept the rank history in the cache directory
+	if len(history) == 0 {
+		ct.loadFileCache(cachekey, &history)
+		if len(history) != 0 && ct.datacache != nil {
+			ct.datacache.Set(cachekey, history, RankHistoryRetention)
+		}
+	}
+	ct.State.rankHistory = history
+}
+
+// recordRanks adds a rank snapshot for each coin that has no snapshot within the snapshot interval
+// and drops the snapshots older than the retention
+func (ct *Cointop) recordRanks(coins []types.Coin) {
+	ct.debuglog("recordRanks()")
+	rankHistoryLock.Lock()
+	defer rankHistoryLock.Unlock()
+	if ct.State.rankHistory == nil {
+		ct.State.rankHistory = make(map[string][]RankSnapshot)
+	}
+
+	now := time.Now()
+	changed := false
+	for _, coin := range coins {
+		if coin.ID == "" || coin.Rank == 0 || coin.Rank == UnrankedCoinRank {
+			continue
+		}
+		snapshots := ct.State.rankHistory[coin.ID]
+		if n := len(snapshots); n > 0 && now.Sub(time.Unix(snapshots[n-1].Timestamp, 0)) < RankSnapshotInterval {
+			continue
+		}
+		ct.State.rankHistory[coin.ID] = append(snapshots, RankSnapshot{
+			Timestamp: now.Unix(),
+			Rank:      coin.Rank,
+		})
+		changed = true
+	}
+	if !changed {
+		return
+	}
+
+	cutoff := now.Add(-RankHistoryRetention).Unix()
+	for id, snapshots := range ct.State.rankHistory {
+		i := 0
+		for i < len(snapshots) && snapshots[i].Timestamp < cutoff {
+			i++
+		}
+		if i == len(snapshots) {
+			delete(ct.State.rankHistory, id)
+			continue
+		}
+		ct.State.rankHistory[id] = snapshots[i:]
+	}
+
+	if ct.datacache != nil {
+		ct.datacache.Set(ct.CacheKey("rankHistory"), ct.State.rankHistory, RankHistoryRetention)
+	}
+}
+
+// nextRankGeneration starts a new refresh of the ranks. The ranks recorded until the next refresh are compared to
+// the ranks of the previous one
+func (ct *Cointop) nextRankGeneration() {
+	rankHistoryLock.Lock()
+	defer rankHistoryLock.Unlock()
+	ct.State.rankGeneration++
+}
+
+// recordRefreshRanks keeps the rank of each coin at the current refresh and the previous one. Coins recorded
+// again within a refresh, eg. by the portfolio refresh, only update the current rank
+func (ct *Cointop) recordRefreshRanks(coins []types.Coin) {
+	rankHistoryLock.Lock()
+	defer rankHistoryLock.Unlock()
+	if ct.State.refreshRanks == nil {
+		ct.State.refreshRanks = make(map[string]*refreshRank)
+	}
+	for _, coin := range coins {
+		if coin.ID == "" || coin.Rank == 0 || coin.Rank == UnrankedCoinRank {
+			continue
+		}
+		entry, ok := ct.State.refreshRanks[coin.ID]
+		if !ok {
+			ct.State.refreshRanks[coin.ID] = &refreshRank{Rank: coin.Rank, Generation: ct.State.rankGeneration}
+			continue
+		}
+		if entry.Generation != ct.State.rankGeneration {
+			entry.PrevRank = entry.Rank
+			entry.HasPrev = true
+			entry.Generation = ct.State.rankGeneration
+		}
+		entry.Rank = coin.Rank
+	}
+}
+
+// RankChange returns how many places the coin climbed since the rank change window, or since the previous refresh
+// in refresh mode, negative if it fell. It returns false when there's no rank to compare to
+func (ct *Cointop) RankChange(coin *Coin) (int, bool) {
+	if coin.Rank == 0 || coin.Rank == UnrankedCoinRank {
+		return 0, false
+	}
+	rankHistoryLock.Lock()
+	defer rankHistoryLock.Unlock()
+	if ct.State.rankChangeMode == RankChangeRefresh {
+		entry, ok := ct.State.refreshRanks[coin.ID]
+		if !ok || !entry.HasPrev {
+			return 0, false
+		}
+		return entry.PrevRank - coin.Rank, true
+	}
+	snapshots := ct.State.rankHistory[coin.ID]
+	cutoff := time.Now().Add(-RankChangeWindow).Unix()
+	for i := len(snapshots) - 1; i >= 0; i-- {
+		if snapshots[i].Timestamp <= cutoff {
+			return snapshots[i].Rank - coin.Rank, true
+		}
+	}
+	return 0, false
+}
+
+// FormatRankChange returns the rank change as an arrow and the number of places. An unknown change is shown as
+// "-", or blank in refresh mode until there's a previous refresh
+func (ct *Cointop) FormatRankChange(change int, ok bool) string {
+	switch {
+	case !ok && ct.State.rankChangeMode == RankChangeRefresh:
+		return ""
+	case !ok:
+		return "-"
+	case change > 0:
+		return fmt.Sprintf("%s%d", ArrowUp, change)
+	case change < 0:
+		return fmt.Sprintf("%s%d", ArrowDown, -change)
+	default:
+		return "0"
+	}
+}
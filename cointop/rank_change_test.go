@@ -0,0 +1,37 @@
+package cointop
+
+import (
+	"testing"
+
+	types "github.com/miguelmota/cointop/pkg/api/types"
+)
+
+// TestRefreshRankChange checks that the refresh mode compares the rank to the previous refresh
+func TestRefreshRankChange(t *testing.T) {
+	ct := &Cointop{State: &State{rankChangeMode: RankChangeRefresh}}
+	coin := &Coin{ID: "bitcoin", Rank: 3}
+
+	ct.nextRankGeneration()
+	ct.recordRefreshRanks([]types.Coin{{ID: "bitcoin", Rank: 3}})
+	if _, ok := ct.RankChange(coin); ok {
+		t.Fatal("expected no rank change on the first refresh")
+	}
+	if text := ct.FormatRankChange(ct.RankChange(coin)); text != "" {
+		t.Errorf("text == %q, want blank", text)
+	}
+
+	ct.nextRankGeneration()
+	ct.recordRefreshRanks([]types.Coin{{ID: "bitcoin", Rank: 1}})
+	// recording again within the refresh keeps the previous refresh to compare to
+	ct.recordRefreshRanks([]types.Coin{{ID: "bitcoin", Rank: 1}})
+	coin.Rank = 1
+	if change, ok := ct.RankChange(coin); !ok || change != 2 {
+		t.Errorf("change == %d, %v, want 2, true", change, ok)
+	}
+
+	ct.nextRankGeneration()
+	ct.recordRefreshRanks([]types.Coin{{ID: "bitcoin", Rank: 1}})
+	if change, ok := ct.RankChange(coin); !ok || change != 0 {
+		t.Errorf("change == %d, %v, want 0, true", change, ok)
+	}
+}
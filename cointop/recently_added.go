@@ -0,0 +1,157 @@
+package cointop
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/api/impl/coingecko"
+	types "github.com/miguelmota/cointop/pkg/api/types"
+)
+
+// GetRecentlyAddedTableHeaders returns the recently added table headers
+func (ct *Cointop) GetRecentlyAddedTableHeaders() []string {
+	return []string{
+		"rank",
+		"name",
+		"symbol",
+		"price",
+		"1h_change",
+		"24h_change",
+		"24h_volume",
+		"market_cap",
+		"date_added",
+	}
+}
+
+// UpdateRecentlyAddedCoins fetches the recently added coins and merges them into the coins list
+func (ct *Cointop) UpdateRecentlyAddedCoins() error {
+	ct.debuglog("updateRecentlyAddedCoins()")
+	cachekey := ct.CacheKey("recentlyAdded")
+
+	var coins []types.Coin
+	cached, found := ct.cache.Get(cachekey)
+	if found {
+		// cache hit
+		coins, _ = cached.([]types.Coin)
+		ct.debuglog("soft cache hit")
+	}
+
+	if coins == nil {
+		if ct.IsOffline() {
+			return ErrOffline
+		}
+		var err error
+		coins, err = ct.API().GetRecentlyAddedCoinData(ct.State.currencyConversion)
+		if err != nil {
+			if errors.Is(err, coingecko.ErrPaidPlanRequired) {
+				go ct.UpdateStatusbar(err.Error())
+			}
+			return err
+		}
+		ct.cache.Set(cachekey, coins, 5*time.Minute)
+	}
+
+	ct.processRecentlyAddedCoins(coins)
+	return nil
+}
+
+// processRecentlyAddedCoins stores the recently added coins so they can be charted and favorited like any other coin
+func (ct *Cointop) processRecentlyAddedCoins(coins []types.Coin) {
+	ct.debuglog("processRecentlyAddedCoins()")
+	coinslock.Lock()
+	defer coinslock.Unlock()
+	updatecoinsmux.Lock()
+	defer updatecoinsmux.Unlock()
+
+	// NOTE: the coins of the list are updated in place by a refresh so they can be other copies than in the map
+	listed := make(map[string]*Coin, len(ct.State.allCoins))
+	for _, coin := range ct.State.allCoins {
+		listed[coin.Name] = coin
+	}
+
+	names := make([]string, 0, len(coins))
+	for _, v := range coins {
+		if ct.IsBlacklisted(v.Name, v.ID) {
+			continue
+		}
+		names = append(names, v.Name)
+		if icoin, ok := ct.State.allCoinsSlugMap.Load(v.Name); ok {
+			coin, _ := icoin.(*Coin)
+			if coin != nil && v.DateAdded != "" {
+				coin.DateAdded = v.DateAdded
+				if c, ok := listed[v.Name]; ok {
+					c.DateAdded = v.DateAdded
+				}
+			}
+			continue
+		}
+
+		// some APIs returns rank 0 for new coins
+		if v.Rank == 0 {
+			v.Rank = UnrankedCoinRank
+		}
+
+		coin := coinFromAPI(v)
+		coin.Favorite = ct.State.favorites[v.Name]
+		ct.State.allCoinsSlugMap.Store(v.Name, coin)
+		ct.State.allCoins = append(ct.State.allCoins, coin)
+	}
+
+	ct.State.recentlyAdded = names
+}
+
+// GetRecentlyAddedSlice returns the recently added coins as slice
+func (ct *Cointop) GetRecentlyAddedSlice() []*Coin {
+	ct.debuglog("getRecentlyAddedSlice()")
+	names := make(map[string]bool)
+	for _, name := range ct.State.recentlyAdded {
+		names[name] = true
+	}
+
+	sliced := []*Coin{}
+	for i := range ct.State.allCoins {
+		coin := ct.State.allCoins[i]
+		if names[coin.Name] {
+			sliced = append(sliced, coin)
+			delete(names, coin.Name)
+		}
+	}
+
+	return sliced
+}
+
+// IsRecentlyAdded returns true if the coin was listed within the last 24 hours
+func (ct *Cointop) IsRecentlyAdded(coin *Coin) bool {
+	if coin == nil || coin.DateAdded == "" {
+		return false
+	}
+	unix, err := strconv.ParseInt(coin.DateAdded, 10, 64)
+	if err != nil {
+		return false
+	}
+	return time.Since(time.Unix(unix, 0)) < 24*time.Hour
+}
+
+// ToggleRecentlyAdded toggles the recently added coins view
+func (ct *Cointop) ToggleRecentlyAdded() error {
+	ct.debuglog("toggleRecentlyAdded()")
+	ct.ToggleSelectedView(RecentlyAddedView)
+	if ct.IsRecentlyAddedVisible() {
+		ct.NavigateFirstLine()
+	}
+	go func() {
+		if ct.IsRecentlyAddedVisible() {
+			if err := ct.UpdateRecentlyAddedCoins(); err != nil {
+				ct.debuglog(err.Error())
+			}
+		}
+		ct.UpdateTable()
+	}()
+	return nil
+}
+
+// IsRecentlyAddedVisible returns true if the recently added view is visible
+func (ct *Cointop) IsRecentlyAddedVisible() bool {
+	return ct.State.selectedView == RecentlyAddedView
+}
@@ -1,10 +1,25 @@
 package cointop
 
 import (
+	"fmt"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
+// sleepCheckInterval is how often the refresh loop checks if the system was asleep
+const sleepCheckInterval = 10 * time.Second
+
+// sleepGapThreshold is how far the wall clock has to run ahead of the monotonic clock to count as a sleep
+const sleepGapThreshold = 30 * time.Second
+
+// RefreshRates are the refresh intervals of the table, chart and marketbar. A zero interval uses the refresh rate
+type RefreshRates struct {
+	Table     time.Duration
+	Chart     time.Duration
+	Marketbar time.Duration
+}
+
 // Refresh triggers a force refresh of coin data
 func (ct *Cointop) Refresh() error {
 	ct.debuglog("refresh()")
@@ -15,6 +30,49 @@ func (ct *Cointop) Refresh() error {
 	return nil
 }
 
+// FullRefresh rebuilds the coin ID list of the API, if enabled, so newly listed coins are found by name and then
+// refreshes the data like a manual refresh
+func (ct *Cointop) FullRefresh() error {
+	ct.debuglog("fullRefresh()")
+	if !ct.cgRefreshIDs {
+		return ct.ManualRefresh()
+	}
+
+	go func() {
+		ct.UpdateStatusbar("Rebuilding coin ID list...")
+		if err := ct.API().RefreshCoinIDs(); err != nil {
+			ct.debuglog(err.Error())
+			ct.UpdateStatusbar("Failed to rebuild coin ID list")
+		} else {
+			ct.UpdateStatusbar("")
+		}
+		ct.ManualRefresh()
+	}()
+	return nil
+}
+
+// PausedStatus is shown in the statusbar while the auto refresh is paused
+const PausedStatus = "Refresh paused"
+
+// TogglePauseRefresh pauses or resumes refreshing the table, chart and marketbar at their intervals.
+// A manual refresh still updates the data while paused
+func (ct *Cointop) TogglePauseRefresh() error {
+	ct.debuglog("togglePauseRefresh()")
+	// NOTE: the pause is read by the refresh and live prices goroutines
+	var paused int32
+	if !ct.IsRefreshPaused() {
+		paused = 1
+	}
+	atomic.StoreInt32(&ct.State.refreshPaused, paused)
+	go ct.RefreshRowLink()
+	return nil
+}
+
+// IsRefreshPaused returns true if the auto refresh is paused
+func (ct *Cointop) IsRefreshPaused() bool {
+	return atomic.LoadInt32(&ct.State.refreshPaused) == 1
+}
+
 // RefreshAll triggers a force refresh of all data
 func (ct *Cointop) RefreshAll() error {
 	ct.debuglog("refreshAll()")
@@ -25,12 +83,114 @@ func (ct *Cointop) RefreshAll() error {
 	ct.cache.Delete("market")
 	go func() {
 		ct.UpdateCoins()
+		if ct.IsRecentlyAddedVisible() {
+			ct.UpdateRecentlyAddedCoins()
+		}
 		ct.UpdateTable()
 		ct.UpdateChart()
 	}()
 	return nil
 }
 
+// RefreshTableData refreshes the coin data of the table
+func (ct *Cointop) RefreshTableData() error {
+	ct.debuglog("refreshTableData()")
+	ct.refreshMux.Lock()
+	defer ct.refreshMux.Unlock()
+	ct.setRefreshStatus()
+	ct.cache.Delete(ct.CacheKey("allCoinsSlugMap"))
+	go func() {
+		ct.UpdateCoins()
+		if ct.IsRecentlyAddedVisible() {
+			ct.UpdateRecentlyAddedCoins()
+		}
+		ct.UpdateTable()
+	}()
+	return nil
+}
+
+// RefreshChartData refreshes the chart data
+func (ct *Cointop) RefreshChartData() error {
+	ct.debuglog("refreshChartData()")
+	go ct.UpdateChart()
+	return nil
+}
+
+// RefreshMarketbarData refreshes the global market data of the marketbar
+func (ct *Cointop) RefreshMarketbarData() error {
+	ct.debuglog("refreshMarketbarData()")
+	ct.cache.Delete(ct.CacheKey("market"))
+	go ct.UpdateMarketbar()
+	return nil
+}
+
+// viewRefreshRate returns the refresh interval of a view, which is the refresh rate unless the view sets its own
+func (ct *Cointop) viewRefreshRate(rate time.Duration) time.Duration {
+	if rate > 0 {
+		return rate
+	}
+	return ct.State.refreshRate
+}
+
+// marketCacheExpiration returns how long the global market data is cached. The chart updates the marketbar too so
+// the data is kept until the marketbar is due to refresh
+func (ct *Cointop) marketCacheExpiration() time.Duration {
+	expiration := 10 * time.Second
+	if ct.State.refreshRates.Marketbar > expiration {
+		return ct.State.refreshRates.Marketbar
+	}
+	return expiration
+}
+
+// newRefreshTicker returns a ticker at the interval, or a stopped ticker for a zero interval so it never fires
+func newRefreshTicker(interval time.Duration) *time.Ticker {
+	if interval <= 0 {
+		ticker := time.NewTicker(time.Duration(1))
+		ticker.Stop()
+		return ticker
+	}
+	return time.NewTicker(interval)
+}
+
+// refreshRatesToToml returns the refresh rates the views set, in seconds
+func (ct *Cointop) refreshRatesToToml() map[string]interface{} {
+	refreshRatesIfc := map[string]interface{}{}
+	for name, rate := range map[string]time.Duration{
+		"table":     ct.State.refreshRates.Table,
+		"chart":     ct.State.refreshRates.Chart,
+		"marketbar": ct.State.refreshRates.Marketbar,
+	} {
+		if rate > 0 {
+			refreshRatesIfc[name] = uint(rate.Seconds())
+		}
+	}
+	return refreshRatesIfc
+}
+
+// loadRefreshRatesFromConfig loads the refresh rates of the views from the config file to struct
+func (ct *Cointop) loadRefreshRatesFromConfig() error {
+	ct.debuglog("loadRefreshRatesFromConfig()")
+	for name, valueIfc := range ct.config.RefreshRates {
+		seconds, ok := valueIfc.(int64)
+		if !ok || seconds < 0 {
+			return fmt.Errorf("invalid refresh_rates %s %v. Expected a number of seconds", name, valueIfc)
+		}
+		rate := time.Duration(seconds) * time.Second
+		switch name {
+		case "table":
+			ct.State.refreshRates.Table = rate
+		case "chart":
+			ct.State.refreshRates.Chart = rate
+		case "marketbar":
+			ct.State.refreshRates.Marketbar = rate
+		default:
+			return fmt.Errorf("invalid refresh_rates view %q. Valid views are \"table\", \"chart\" and \"marketbar\"", name)
+		}
+	}
+
+	return nil
+}
+
 // SetRefreshStatus sets the refresh ticker
 func (ct *Cointop) setRefreshStatus() {
 	ct.debuglog("setRefreshStatus()")
@@ -55,16 +215,50 @@ func (ct *Cointop) loadingTicks(s string, t int) {
 	}
 }
 
-// intervalFetchData does a force refresh at every interval
+// SleptBetween returns true if the system was suspended between the two times.
+// The monotonic clock doesn't advance while suspended so the wall clock runs ahead of it.
+func SleptBetween(last time.Time, now time.Time) bool {
+	wall := now.Round(0).Sub(last.Round(0))
+	monotonic := now.Sub(last)
+	return wall-monotonic > sleepGapThreshold
+}
+
+// intervalFetchData refreshes the table, chart and marketbar at their intervals and does a force refresh after the
+// system resumes from sleep. Only force refreshes are done while the auto refresh is paused
 func (ct *Cointop) intervalFetchData() {
 	ct.debuglog("intervalFetchData()")
 	go func() {
+		var sleepCheck <-chan time.Time
+		if ct.State.refreshOnResume {
+			sleepTicker := time.NewTicker(sleepCheckInterval)
+			defer sleepTicker.Stop()
+			sleepCheck = sleepTicker.C
+		}
+		lastCheck := time.Now()
 		for {
 			select {
 			case <-ct.forceRefresh:
-				ct.RefreshAll()
-			case <-ct.refreshTicker.C:
-				ct.RefreshAll()
+				if ct.checkOnline() {
+					ct.RefreshAll()
+				}
+			case <-ct.tableTicker.C:
+				if !ct.IsRefreshPaused() && ct.checkOnline() {
+					ct.RefreshTableData()
+				}
+			case <-ct.chartTicker.C:
+				if !ct.IsRefreshPaused() && ct.checkOnline() {
+					ct.RefreshChartData()
+				}
+			case <-ct.marketbarTicker.C:
+				if !ct.IsRefreshPaused() && ct.checkOnline() {
+					ct.RefreshMarketbarData()
+				}
+			case now := <-sleepCheck:
+				if SleptBetween(lastCheck, now) && !ct.IsRefreshPaused() {
+					ct.debuglog("resumed from sleep")
+					ct.Refresh()
+				}
+				lastCheck = now
 			}
 		}
 	}()
@@ -0,0 +1,152 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// ShowConfirmMenu shows a menu asking to confirm the action described by the message before running it
+func (ct *Cointop) ShowConfirmMenu(message string, fn func() error) error {
+	ct.debuglog("showConfirmMenu()")
+	ct.State.confirmMessage = message
+	ct.State.confirmFn = fn
+	ct.UpdateConfirmMenu()
+	ct.SetActiveView(ct.Views.Menu.Name())
+	return nil
+}
+
+// UpdateConfirmMenu updates the confirmation menu
+func (ct *Cointop) UpdateConfirmMenu() error {
+	ct.debuglog("updateConfirmMenu()")
+	title := "Confirm"
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" %s %s\n\n", title, pad.Left("[q] close ", ct.width()-len(title)-5, " ")))
+	content := fmt.Sprintf("%s %s\n\n [y] Yes    [n] No", header, ct.colorscheme.Menu(ct.State.confirmMessage))
+
+	ct.UpdateUI(func() error {
+		if ct.State.confirmFn == nil {
+			return nil
+		}
+		ct.Views.Menu.SetFrame(true)
+		return ct.Views.Menu.Update(content)
+	})
+	return nil
+}
+
+// HideConfirmMenu hides the confirmation menu without running the action
+func (ct *Cointop) HideConfirmMenu() error {
+	ct.debuglog("hideConfirmMenu()")
+	if ct.State.confirmFn == nil {
+		return nil
+	}
+
+	ct.State.confirmFn = nil
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		return ct.Views.Menu.Update("")
+	})
+	return nil
+}
+
+// Confirm hides the confirmation menu and runs the action
+func (ct *Cointop) Confirm() error {
+	ct.debuglog("confirm()")
+	fn := ct.State.confirmFn
+	if fn == nil {
+		return nil
+	}
+	ct.HideConfirmMenu()
+	return fn()
+}
+
+// removeFavoriteCoin removes the coin from the favorites and keeps it to be restored with undo
+func (ct *Cointop) removeFavoriteCoin(coin *Coin) error {
+	delete(ct.State.favorites, coin.Name)
+	coin.Favorite = false
+	ct.State.removedFavorite = coin.Name
+	ct.State.lastRemovedFrom = FavoritesView
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go ct.UpdateTable()
+
+	return nil
+}
+
+// removePortfolioCoin removes the coin from the portfolio and keeps its entry to be restored with undo
+func (ct *Cointop) removePortfolioCoin(coin *Coin) error {
+	if entry, isNew := ct.PortfolioEntry(coin); !isNew {
+		ct.State.removedPortfolioEntry = entry
+		ct.State.lastRemovedFrom = PortfolioView
+	}
+	ct.RemovePortfolioEntry(coin.Name)
+	ct.UpdateTable()
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	ct.ToggleShowPortfolio()
+	return nil
+}
+
+// UndoRemoval restores the last coin removed from the favorites or the portfolio. In the favorites
+// or portfolio view the last coin removed from that list is restored
+func (ct *Cointop) UndoRemoval() error {
+	ct.debuglog("undoRemoval()")
+	list := ct.State.lastRemovedFrom
+	if ct.IsFavoritesVisible() {
+		list = FavoritesView
+	} else if ct.IsPortfolioVisible() {
+		list = PortfolioView
+	}
+
+	var name string
+	switch list {
+	case FavoritesView:
+		name = ct.State.removedFavorite
+		if name == "" {
+			break
+		}
+		ct.State.favorites[name] = true
+		if ic, ok := ct.State.allCoinsSlugMap.Load(name); ok {
+			if coin, ok := ic.(*Coin); ok {
+				coin.Favorite = true
+			}
+		}
+		ct.State.removedFavorite = ""
+		ct.State.lastRemovedFrom = ""
+		if ct.State.removedPortfolioEntry != nil {
+			ct.State.lastRemovedFrom = PortfolioView
+		}
+		name = fmt.Sprintf("%s to favorites", name)
+	case PortfolioView:
+		entry := ct.State.removedPortfolioEntry
+		if entry == nil {
+			break
+		}
+		ct.State.portfolio.Entries[strings.ToLower(entry.Coin)] = entry
+		ct.State.removedPortfolioEntry = nil
+		ct.State.lastRemovedFrom = ""
+		if ct.State.removedFavorite != "" {
+			ct.State.lastRemovedFrom = FavoritesView
+		}
+		name = fmt.Sprintf("%s to the portfolio", entry.Coin)
+	}
+	if name == "" {
+		ct.UpdateStatusbar("Nothing to undo")
+		return nil
+	}
+
+	if err := ct.Save(); err != nil {
+		return err
+	}
+	go ct.UpdateTable()
+	ct.UpdateStatusbar(fmt.Sprintf("Restored %s", name))
+	return nil
+}
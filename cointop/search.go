@@ -30,6 +30,7 @@ func NewInputView() *InputView {
 func (ct *Cointop) openSearch() error {
 	ct.debuglog("openSearch()")
 	ct.State.searchFieldVisible = true
+	ct.State.searchHistoryIndex = len(ct.State.searchHistory)
 	ct.ui.SetCursor(true)
 	ct.SetActiveView(ct.Views.SearchField.Name())
 	return nil
@@ -47,6 +48,17 @@ func (ct *Cointop) CancelSearch() error {
 // DoSearch triggers the search and sets views
 func (ct *Cointop) DoSearch() error {
 	ct.debuglog("doSearch()")
+	return ct.doSearch(ct.State.favoriteOnSearch)
+}
+
+// DoSearchAndFavorite triggers the search and adds the found coin to the favorites
+func (ct *Cointop) DoSearchAndFavorite() error {
+	ct.debuglog("doSearchAndFavorite()")
+	return ct.doSearch(true)
+}
+
+// doSearch reads the search field and searches, optionally adding the found coin to the favorites
+func (ct *Cointop) doSearch(favorite bool) error {
 	ct.Views.SearchField.Rewind()
 	b := make([]byte, 100)
 	n, err := ct.Views.SearchField.Read(b)
@@ -57,27 +69,55 @@ func (ct *Cointop) DoSearch() error {
 	// TODO: do this a better way (SoC)
 	ct.SetSelectedView(CoinsView)
 
-	defer ct.SetActiveView(ct.Views.Table.Name())
-	if err != nil {
-		return nil
-	}
 	if n == 0 {
+		ct.SetActiveView(ct.Views.Table.Name())
 		return nil
 	}
-	q := string(b)
+	q := string(b[:n])
 	// remove slash
 	regex := regexp.MustCompile(`/(.*)`)
 	matches := regex.FindStringSubmatch(q)
 	if len(matches) > 0 {
 		q = matches[1]
 	}
-	return ct.Search(q)
+	if err := ct.addSearchHistory(q); err != nil {
+		return err
+	}
+	if ct.State.fuzzySearch {
+		return ct.doFuzzySearch(q, favorite)
+	}
+	if idxs := ct.symbolMatchIndexes(q); len(idxs) > 1 && ct.State.symbolCollision == SymbolCollisionAsk {
+		return ct.ShowSearchChoiceMenu(idxs, favorite)
+	}
+	ct.SetActiveView(ct.Views.Table.Name())
+	idx := ct.searchCoinIndex(q)
+	if idx == -1 {
+		return nil
+	}
+	ct.goToCoinIndex(idx)
+	if coin := ct.State.allCoins[idx]; favorite && !coin.Favorite {
+		return ct.toggleFavoriteCoin(coin)
+	}
+	return nil
 }
 
 // Search performs the search and filtering
 func (ct *Cointop) Search(q string) error {
 	ct.debuglog("search()")
+	if idx := ct.searchCoinIndex(q); idx != -1 {
+		ct.goToCoinIndex(idx)
+	}
+	return nil
+}
+
+// searchCoinIndex returns the index of the best matching coin, or -1 if there's no match.
+// A symbol shared by several coins matches the highest ranked coin.
+func (ct *Cointop) searchCoinIndex(q string) int {
 	q = strings.TrimSpace(strings.ToLower(q))
+	// if query matches symbol, return immediately
+	if idxs := ct.symbolMatchIndexes(q); len(idxs) > 0 {
+		return idxs[0]
+	}
 	idx := -1
 	min := -1
 	var hasprefixidx []int
@@ -85,16 +125,9 @@ func (ct *Cointop) Search(q string) error {
 	for i := range ct.State.allCoins {
 		coin := ct.State.allCoins[i]
 		name := strings.ToLower(coin.Name)
-		symbol := strings.ToLower(coin.Symbol)
-		// if query matches symbol, return immediately
-		if symbol == q {
-			ct.GoToGlobalIndex(i)
-			return nil
-		}
 		// if query matches name, return immediately
 		if name == q {
-			ct.GoToGlobalIndex(i)
-			return nil
+			return i
 		}
 		// store index with the smallest levenshtein
 		dist := levenshtein.DamerauLevenshteinDistance(name, q)
@@ -112,13 +145,11 @@ func (ct *Cointop) Search(q string) error {
 	}
 	// go to row if prefix match
 	if len(hasprefixidx) > 0 && hasprefixidx[0] != -1 && min > 0 {
-		ct.GoToGlobalIndex(hasprefixidx[0])
-		return nil
+		return hasprefixidx[0]
 	}
 	// go to row if levenshtein distance is small enough
 	if idx > -1 && min <= 6 {
-		ct.GoToGlobalIndex(idx)
-		return nil
+		return idx
 	}
-	return nil
+	return -1
 }
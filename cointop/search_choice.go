@@ -0,0 +1,124 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/miguelmota/cointop/pkg/pad"
+)
+
+// SymbolCollisionAsk shows a list to choose from when a searched symbol matches several coins
+const SymbolCollisionAsk = "ask"
+
+// SymbolCollisionRank picks the highest ranked coin when a searched symbol matches several coins
+const SymbolCollisionRank = "rank"
+
+// MaxSearchChoices is the max number of coins listed when a searched symbol matches several coins
+const MaxSearchChoices = 9
+
+// symbolMatchIndexes returns the indexes of the coins with the symbol, highest ranked first
+func (ct *Cointop) symbolMatchIndexes(symbol string) []int {
+	symbol = strings.TrimSpace(strings.ToLower(symbol))
+	var idxs []int
+	for i, coin := range ct.State.allCoins {
+		if strings.ToLower(coin.Symbol) == symbol {
+			idxs = append(idxs, i)
+		}
+	}
+	sort.SliceStable(idxs, func(i, j int) bool {
+		return ct.State.allCoins[idxs[i]].Rank < ct.State.allCoins[idxs[j]].Rank
+	})
+	return idxs
+}
+
+// ShowSearchChoiceMenu shows the list of coins sharing the searched symbol
+func (ct *Cointop) ShowSearchChoiceMenu(idxs []int, favorite bool) error {
+	ct.debuglog("showSearchChoiceMenu()")
+	return ct.showSearchChoices(idxs, favorite, "Several coins have this symbol. Press the corresponding key to select the coin")
+}
+
+// showSearchChoices shows the list of coins to choose from with the help line
+func (ct *Cointop) showSearchChoices(idxs []int, favorite bool, help string) error {
+	if len(idxs) > MaxSearchChoices {
+		idxs = idxs[:MaxSearchChoices]
+	}
+	var names []string
+	for _, idx := range idxs {
+		names = append(names, ct.State.allCoins[idx].Name)
+	}
+	ct.State.searchChoices = names
+	ct.State.searchChoiceFavorite = favorite
+	ct.State.searchChoiceHelp = help
+	ct.UpdateSearchChoiceMenu()
+	ct.SetActiveView(ct.Views.Menu.Name())
+	return nil
+}
+
+// UpdateSearchChoiceMenu updates the list of coins to choose from
+func (ct *Cointop) UpdateSearchChoiceMenu() error {
+	ct.debuglog("updateSearchChoiceMenu()")
+	title := "Choose Coin"
+	header := ct.colorscheme.MenuHeader(fmt.Sprintf(" %s %s\n\n", title, pad.Left("[q] close ", ct.width()-len(title)-5, " ")))
+	helpline := fmt.Sprintf(" %s\n\n", ct.State.searchChoiceHelp)
+	var rows []string
+	for i, name := range ct.State.searchChoices {
+		ic, _ := ct.State.allCoinsSlugMap.Load(name)
+		coin, _ := ic.(*Coin)
+		if coin == nil {
+			continue
+		}
+		rows = append(rows, fmt.Sprintf(" [ %d ] %s %s", i+1, ct.colorscheme.Menu(fmt.Sprintf("%s (%s)", coin.Name, coin.Symbol)), ct.colorscheme.MenuLabel(fmt.Sprintf("#%d", coin.Rank))))
+	}
+	content := fmt.Sprintf("%s%s%s\n\n [ESC] Cancel", header, helpline, strings.Join(rows, "\n"))
+
+	ct.UpdateUI(func() error {
+		if len(ct.State.searchChoices) == 0 {
+			return nil
+		}
+		ct.Views.Menu.SetFrame(true)
+		return ct.Views.Menu.Update(content)
+	})
+	return nil
+}
+
+// HideSearchChoiceMenu hides the list of coins to choose from
+func (ct *Cointop) HideSearchChoiceMenu() error {
+	ct.debuglog("hideSearchChoiceMenu()")
+	if len(ct.State.searchChoices) == 0 {
+		return nil
+	}
+
+	ct.State.searchChoices = nil
+	ct.ui.SetViewOnBottom(ct.Views.Menu)
+	ct.SetActiveView(ct.Views.Table.Name())
+	ct.UpdateUI(func() error {
+		ct.Views.Menu.SetFrame(false)
+		return ct.Views.Menu.Update("")
+	})
+	return nil
+}
+
+// SelectSearchChoiceFn returns the function that goes to the coin at the position in the list of coins to choose from
+func (ct *Cointop) SelectSearchChoiceFn(i int) func() error {
+	return func() error {
+		if i >= len(ct.State.searchChoices) {
+			return nil
+		}
+		name := ct.State.searchChoices[i]
+		favorite := ct.State.searchChoiceFavorite
+		ct.HideSearchChoiceMenu()
+
+		for idx, coin := range ct.State.allCoins {
+			if coin.Name != name {
+				continue
+			}
+			ct.goToCoinIndex(idx)
+			if favorite && !coin.Favorite {
+				return ct.toggleFavoriteCoin(coin)
+			}
+			return nil
+		}
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// fuzzy search match kinds, best first
+const (
+	fuzzyMatchSymbol = iota
+	fuzzyMatchNamePrefix
+	fuzzyMatchSubsequence
+)
+
+// fuzzyMatch returns the kind of match of the query in the name or symbol of the coin and, for subsequence matches,
+// the length of the matched part so tighter matches rank higher. It returns false if the coin doesn't match
+func fuzzyMatch(coin *Coin, q string) (int, int, bool) {
+	name := strings.ToLower(coin.Name)
+	symbol := strings.ToLower(coin.Symbol)
+	if symbol == q {
+		return fuzzyMatchSymbol, 0, true
+	}
+	if strings.HasPrefix(name, q) {
+		return fuzzyMatchNamePrefix, 0, true
+	}
+	span, ok := subsequenceSpan(name, q)
+	if symbolSpan, symbolOk := subsequenceSpan(symbol, q); symbolOk && (!ok || symbolSpan < span) {
+		span, ok = symbolSpan, true
+	}
+	return fuzzyMatchSubsequence, span, ok
+}
+
+// subsequenceSpan returns the length of the part of s from the first to the last rune of the subsequence q, and
+// false if the runes of q don't all appear in s in order
+func subsequenceSpan(s string, q string) (int, bool) {
+	runes := []rune(s)
+	start := -1
+	i := 0
+	for _, r := range q {
+		for i < len(runes) && runes[i] != r {
+			i++
+		}
+		if i == len(runes) {
+			return 0, false
+		}
+		if start == -1 {
+			start = i
+		}
+		i++
+	}
+	return i - start, true
+}
+
+// fuzzySearchIndexes returns the indexes of up to max coins matching the query, best first. Symbol matches rank
+// above name prefix matches, which rank above subsequence matches of the name or symbol. Ties go to the higher rank
+func (ct *Cointop) fuzzySearchIndexes(q string, max int) []int {
+	q = strings.TrimSpace(strings.ToLower(q))
+	if q == "" {
+		return nil
+	}
+
+	type match struct {
+		idx  int
+		kind int
+		span int
+	}
+	var matches []match
+	for i, coin := range ct.State.allCoins {
+		if kind, span, ok := fuzzyMatch(coin, q); ok {
+			matches = append(matches, match{idx: i, kind: kind, span: span})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.kind != b.kind {
+			return a.kind < b.kind
+		}
+		if a.span != b.span {
+			return a.span < b.span
+		}
+		return ct.State.allCoins[a.idx].Rank < ct.State.allCoins[b.idx].Rank
+	})
+
+	var idxs []int
+	for _, m := range matches {
+		if len(idxs) >= max {
+			break
+		}
+		idxs = append(idxs, m.idx)
+	}
+	return idxs
+}
+
+// doFuzzySearch lists the best matching coins to choose from, or goes to the coin if only one matches
+func (ct *Cointop) doFuzzySearch(q string, favorite bool) error {
+	ct.debuglog("doFuzzySearch()")
+	idxs := ct.fuzzySearchIndexes(q, MaxSearchChoices)
+	if len(idxs) > 1 {
+		return ct.showSearchChoices(idxs, favorite, "Best matches first. Press the corresponding key to select the coin")
+	}
+
+	ct.SetActiveView(ct.Views.Table.Name())
+	if len(idxs) == 0 {
+		ct.UpdateStatusbar(fmt.Sprintf("No coins match %q", strings.TrimSpace(q)))
+		return nil
+	}
+	ct.goToCoinIndex(idxs[0])
+	if coin := ct.State.allCoins[idxs[0]]; favorite && !coin.Favorite {
+		return ct.toggleFavoriteCoin(coin)
+	}
+	return nil
+}
+
+// loadFuzzySearchFromConfig loads the fuzzy search setting from config file to struct
+func (ct *Cointop) loadFuzzySearchFromConfig() error {
+	ct.debuglog("loadFuzzySearchFromConfig()")
+	if fuzzySearch, ok := ct.config.FuzzySearch.(bool); ok {
+		ct.State.fuzzySearch = fuzzySearch
+	}
+
+	return nil
+}
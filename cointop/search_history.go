@@ -0,0 +1,109 @@
+package cointop
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultSearchHistorySize is the default number of searches kept in the search history
+const DefaultSearchHistorySize = 20
+
+// addSearchHistory adds the search to the end of the search history. A search equal to the last one isn't added
+// again and the oldest searches are dropped when the history is full
+func (ct *Cointop) addSearchHistory(q string) error {
+	ct.debuglog("addSearchHistory()")
+	q = strings.TrimSpace(q)
+	history := ct.State.searchHistory
+	if q == "" || ct.State.searchHistorySize == 0 {
+		return nil
+	}
+	if n := len(history); n > 0 && history[n-1] == q {
+		return nil
+	}
+	history = append(history, q)
+	if len(history) > ct.State.searchHistorySize {
+		history = history[len(history)-ct.State.searchHistorySize:]
+	}
+	ct.State.searchHistory = history
+	ct.State.searchHistoryIndex = len(history)
+	if !ct.State.saveSearchHistory {
+		return nil
+	}
+	return ct.SaveConfig()
+}
+
+// PreviousSearch fills the search field with the previous search in the search history
+func (ct *Cointop) PreviousSearch() error {
+	ct.debuglog("previousSearch()")
+	if ct.State.searchHistoryIndex <= 0 {
+		return nil
+	}
+	ct.State.searchHistoryIndex--
+	return ct.setSearchField(ct.State.searchHistory[ct.State.searchHistoryIndex])
+}
+
+// NextSearch fills the search field with the next search in the search history, or clears it after the last search
+func (ct *Cointop) NextSearch() error {
+	ct.debuglog("nextSearch()")
+	if ct.State.searchHistoryIndex >= len(ct.State.searchHistory) {
+		return nil
+	}
+	ct.State.searchHistoryIndex++
+	q := ""
+	if ct.State.searchHistoryIndex < len(ct.State.searchHistory) {
+		q = ct.State.searchHistory[ct.State.searchHistoryIndex]
+	}
+	return ct.setSearchField(q)
+}
+
+// setSearchField sets the search field to the search with the cursor at the end
+func (ct *Cointop) setSearchField(q string) error {
+	value := fmt.Sprintf("/%s", q)
+	ct.Views.SearchField.Update(value)
+	return ct.Views.SearchField.SetCursor(utf8.RuneCountInString(value), 0)
+}
+
+// searchHistoryToToml returns the search history config section
+func (ct *Cointop) searchHistoryToToml() map[string]interface{} {
+	var entriesIfc interface{} = []string{}
+	if ct.State.saveSearchHistory {
+		entriesIfc = ct.State.searchHistory
+	}
+	return map[string]interface{}{
+		"size":    ct.State.searchHistorySize,
+		"save":    ct.State.saveSearchHistory,
+		"entries": entriesIfc,
+	}
+}
+
+// loadSearchHistoryFromConfig loads the search history settings and the saved searches from config file to struct
+func (ct *Cointop) loadSearchHistoryFromConfig() error {
+	ct.debuglog("loadSearchHistoryFromConfig()")
+	if size, ok := ct.config.SearchHistory["size"].(int64); ok {
+		if size < 0 {
+			return fmt.Errorf("invalid search history size %d. It must not be negative", size)
+		}
+		ct.State.searchHistorySize = int(size)
+	}
+	if save, ok := ct.config.SearchHistory["save"].(bool); ok {
+		ct.State.saveSearchHistory = save
+	}
+	if !ct.State.saveSearchHistory {
+		return nil
+	}
+	if ifcs, ok := ct.config.SearchHistory["entries"].([]interface{}); ok {
+		var history []string
+		for _, ifc := range ifcs {
+			if q, ok := ifc.(string); ok && strings.TrimSpace(q) != "" {
+				history = append(history, strings.TrimSpace(q))
+			}
+		}
+		if len(history) > ct.State.searchHistorySize {
+			history = history[len(history)-ct.State.searchHistorySize:]
+		}
+		ct.State.searchHistory = history
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package cointop
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSearchCollidingSymbol checks that a symbol shared by several coins lists them by rank and matches the highest ranked
+func TestSearchCollidingSymbol(t *testing.T) {
+	ct := &Cointop{
+		State: &State{
+			allCoins: []*Coin{
+				{Name: "Universe", Symbol: "UNI", Rank: 900},
+				{Name: "Bitcoin", Symbol: "BTC", Rank: 1},
+				{Name: "Uniswap", Symbol: "UNI", Rank: 20},
+			},
+		},
+	}
+
+	idxs := ct.symbolMatchIndexes("uni")
+	if len(idxs) != 2 {
+		t.Fatalf("matches == %d, want 2", len(idxs))
+	}
+	if name := ct.State.allCoins[idxs[0]].Name; name != "Uniswap" {
+		t.Errorf("first match == %q, want %q", name, "Uniswap")
+	}
+	if name := ct.State.allCoins[idxs[1]].Name; name != "Universe" {
+		t.Errorf("second match == %q, want %q", name, "Universe")
+	}
+
+	if idx := ct.searchCoinIndex(" UNI "); idx != 2 {
+		t.Errorf("search index == %d, want 2", idx)
+	}
+	if idxs := ct.symbolMatchIndexes("btc"); len(idxs) != 1 {
+		t.Errorf("matches == %d, want 1", len(idxs))
+	}
+}
+
+// TestFuzzySearch checks that fuzzy search ranks symbol matches, then name prefixes, then subsequences
+func TestFuzzySearch(t *testing.T) {
+	ct := &Cointop{
+		State: &State{
+			allCoins: []*Coin{
+				{Name: "Ethereum Classic", Symbol: "ETC", Rank: 30},
+				{Name: "Ethereum", Symbol: "ETH", Rank: 2},
+				{Name: "Tether", Symbol: "USDT", Rank: 3},
+				{Name: "Bitcoin", Symbol: "BTC", Rank: 1},
+				{Name: "Ether.fi", Symbol: "ETHFI", Rank: 200},
+			},
+		},
+	}
+
+	names := func(idxs []int) []string {
+		var list []string
+		for _, idx := range idxs {
+			list = append(list, ct.State.allCoins[idx].Name)
+		}
+		return list
+	}
+
+	got := names(ct.fuzzySearchIndexes("etherm", MaxSearchChoices))
+	want := []string{"Ethereum", "Ethereum Classic"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("matches == %v, want %v", got, want)
+	}
+
+	got = names(ct.fuzzySearchIndexes(" ETH ", MaxSearchChoices))
+	want = []string{"Ethereum", "Ethereum Classic", "Ether.fi", "Tether"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("matches == %v, want %v", got, want)
+	}
+
+	if idxs := ct.fuzzySearchIndexes("eth", 1); len(idxs) != 1 {
+		t.Errorf("matches == %d, want 1", len(idxs))
+	}
+	if idxs := ct.fuzzySearchIndexes("xyz", MaxSearchChoices); len(idxs) != 0 {
+		t.Errorf("matches == %d, want 0", len(idxs))
+	}
+}
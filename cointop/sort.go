@@ -1,6 +1,7 @@
 package cointop
 
 import (
+	"fmt"
 	"sort"
 	"sync"
 
@@ -9,6 +10,147 @@ import (
 
 var sortlock sync.Mutex
 
+// UnrankedCoinRank is the rank given to coins that APIs return without a rank
+const UnrankedCoinRank = 10000
+
+// unrankedCoinLess orders coins sharing a rank by market cap, largest first, and then by name
+func unrankedCoinLess(a, b *Coin) bool {
+	if a.MarketCap != b.MarketCap {
+		return a.MarketCap > b.MarketCap
+	}
+	return a.Name < b.Name
+}
+
+// RankUnrankedCoins gives the coins consecutive ranks starting at UnrankedCoinRank
+// in a deterministic order so they sort the same across sessions
+func RankUnrankedCoins(coins []*Coin) {
+	sort.Slice(coins, func(i, j int) bool {
+		return unrankedCoinLess(coins[i], coins[j])
+	})
+	for i, coin := range coins {
+		coin.Rank = UnrankedCoinRank + i
+	}
+}
+
+// ViewSort is the sort of a table view
+type ViewSort struct {
+	SortBy   string
+	SortDesc bool
+}
+
+// DefaultViewSorts returns the sorts of the table views that don't sort by rank by default
+func DefaultViewSorts() map[string]*ViewSort {
+	return map[string]*ViewSort{
+		RecentlyAddedView: {
+			SortBy:   "date_added",
+			SortDesc: true,
+		},
+		GainersView: {
+			SortBy:   "24h_change",
+			SortDesc: true,
+		},
+		LosersView: {
+			SortBy:   "24h_change",
+			SortDesc: false,
+		},
+	}
+}
+
+// sortViewName returns the name of the view a sort is stored under
+func sortViewName(viewName string) string {
+	if viewName == "" {
+		return CoinsView
+	}
+	return viewName
+}
+
+// SwapViewSort stores the sort of the view being left and restores the sort of the view being shown.
+// A view without a stored sort is sorted by rank.
+func (ct *Cointop) SwapViewSort(fromView string, toView string) {
+	fromView, toView = sortViewName(fromView), sortViewName(toView)
+	if fromView == toView {
+		return
+	}
+	ct.State.viewSorts[fromView] = &ViewSort{
+		SortBy:   ct.State.sortBy,
+		SortDesc: ct.State.sortDesc,
+	}
+	if viewSort, ok := ct.State.viewSorts[toView]; ok {
+		ct.State.sortBy = viewSort.SortBy
+		ct.State.sortDesc = viewSort.SortDesc
+	} else {
+		ct.State.sortBy = "rank"
+		ct.State.sortDesc = false
+	}
+}
+
+// viewSortsToToml returns the sort of each view as config tuples
+func (ct *Cointop) viewSortsToToml() [][]string {
+	viewSorts := map[string]*ViewSort{}
+	for k, v := range ct.State.viewSorts {
+		viewSorts[k] = v
+	}
+	viewSorts[sortViewName(ct.State.selectedView)] = &ViewSort{
+		SortBy:   ct.State.sortBy,
+		SortDesc: ct.State.sortDesc,
+	}
+
+	var viewSortsIfc [][]string
+	for viewName, viewSort := range viewSorts {
+		direction := "asc"
+		if viewSort.SortDesc {
+			direction = "desc"
+		}
+		viewSortsIfc = append(viewSortsIfc, []string{viewName, viewSort.SortBy, direction})
+	}
+	sort.Slice(viewSortsIfc, func(i, j int) bool {
+		return viewSortsIfc[i][0] < viewSortsIfc[j][0]
+	})
+	return viewSortsIfc
+}
+
+// loadViewSortsFromConfig loads the sort of each view from the config tuples
+func (ct *Cointop) loadViewSortsFromConfig(valueIfc interface{}) error {
+	ct.debuglog("loadViewSortsFromConfig()")
+	viewSortsIfc, ok := valueIfc.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, itemIfc := range viewSortsIfc {
+		tupleIfc, ok := itemIfc.([]interface{})
+		if !ok || len(tupleIfc) != 3 {
+			continue
+		}
+		viewName, _ := tupleIfc[0].(string)
+		sortBy, _ := tupleIfc[1].(string)
+		direction, _ := tupleIfc[2].(string)
+		switch viewName {
+		case CoinsView, FavoritesView, PortfolioView, RecentlyAddedView, GainersView, LosersView, PriceAlertsView:
+		default:
+			return fmt.Errorf("invalid view_sort view %q", viewName)
+		}
+		if direction != "asc" && direction != "desc" {
+			return fmt.Errorf("invalid view_sort direction %q. Valid values are \"asc\" and \"desc\"", direction)
+		}
+		if sortBy == "" {
+			continue
+		}
+		ct.State.viewSorts[viewName] = &ViewSort{
+			SortBy:   sortBy,
+			SortDesc: direction == "desc",
+		}
+	}
+
+	// NOTE: apply the sort of the view shown on startup
+	if viewSort, ok := ct.State.viewSorts[sortViewName(ct.State.selectedView)]; ok {
+		ct.State.sortBy = viewSort.SortBy
+		ct.State.sortDesc = viewSort.SortDesc
+	}
+
+	return nil
+}
+
 // Sort sorts the list of coins
 func (ct *Cointop) Sort(sortBy string, desc bool, list []*Coin, renderHeaders bool) {
 	ct.debuglog("sort()")
@@ -34,8 +176,16 @@ func (ct *Cointop) Sort(sortBy string, desc bool, list []*Coin, renderHeaders bo
 		if b == nil {
 			return false
 		}
-		switch sortBy {
+		key := sortBy
+		// NOTE: the prices in other currencies are the price times a shared rate so they sort the same
+		if _, ok := priceCurrencyFromColumn(sortBy); ok {
+			key = "price"
+		}
+		switch key {
 		case "rank":
+			if a.Rank == b.Rank {
+				return unrankedCoinLess(a, b)
+			}
 			return a.Rank < b.Rank
 		case "name":
 			return a.Name < b.Name
@@ -47,10 +197,18 @@ func (ct *Cointop) Sort(sortBy string, desc bool, list []*Coin, renderHeaders bo
 			return a.Holdings < b.Holdings
 		case "balance":
 			return a.Balance < b.Balance
+		case "cost_price":
+			return a.CostPrice < b.CostPrice
+		case "cost":
+			return a.Cost < b.Cost
+		case "pnl":
+			return a.ProfitLoss < b.ProfitLoss
+		case "pnl_percent":
+			return a.PercentProfitLoss < b.PercentProfitLoss
 		case "market_cap":
 			return a.MarketCap < b.MarketCap
 		case "24h_volume":
-			return a.Volume24H < b.Volume24H
+			return ct.VolumeValue(a) < ct.VolumeValue(b)
 		case "1h_change":
 			return a.PercentChange1H < b.PercentChange1H
 		case "24h_change":
@@ -59,12 +217,91 @@ func (ct *Cointop) Sort(sortBy string, desc bool, list []*Coin, renderHeaders bo
 			return a.PercentChange7D < b.PercentChange7D
 		case "30d_change":
 			return a.PercentChange30D < b.PercentChange30D
+		case "change":
+			return ct.ChangeWindowPercent(a) < ct.ChangeWindowPercent(b)
 		case "total_supply":
 			return a.TotalSupply < b.TotalSupply
 		case "available_supply":
 			return a.AvailableSupply < b.AvailableSupply
+		case "fdv":
+			return a.FDV < b.FDV
+		case "fdv_mcap":
+			// unknown ratios sort below every known ratio
+			ra, oka := FDVRatio(a)
+			rb, okb := FDVRatio(b)
+			if oka != okb {
+				return okb
+			}
+			return ra < rb
+		case "volume_mcap":
+			// coins without a market cap sort last in both orders
+			ra, oka := VolumeMarketCapRatio(a)
+			rb, okb := VolumeMarketCapRatio(b)
+			if oka != okb {
+				return oka != ct.State.sortDesc
+			}
+			return ra < rb
+		case "24h_high":
+			return a.High24H < b.High24H
+		case "24h_low":
+			return a.Low24H < b.Low24H
+		case "ath":
+			return a.ATH < b.ATH
+		case "ath_change":
+			// unknown percents sort below every known percent
+			pa, oka := PercentFromATH(a)
+			pb, okb := PercentFromATH(b)
+			if oka != okb {
+				return okb
+			}
+			return pa < pb
+		case "circ_pct":
+			// unknown percents sort below every known percent
+			pa, oka := CirculatingSupplyPercent(a)
+			pb, okb := CirculatingSupplyPercent(b)
+			if oka != okb {
+				return okb
+			}
+			return pa < pb
+		case "max_supply":
+			// coins without a max supply sort above every max supply
+			if (a.MaxSupply <= 0) != (b.MaxSupply <= 0) {
+				return b.MaxSupply <= 0
+			}
+			return a.MaxSupply < b.MaxSupply
+		case "circ_max_pct":
+			// unknown percents sort below every known percent
+			pa, oka := CirculatingMaxSupplyPercent(a)
+			pb, okb := CirculatingMaxSupplyPercent(b)
+			if oka != okb {
+				return okb
+			}
+			return pa < pb
+		case "sparkline_7d":
+			// NOTE: the sparkline shows the 7d trend so it sorts like the 7d change
+			return a.PercentChange7D < b.PercentChange7D
+		case "rs_btc":
+			// NOTE: every coin is compared to the same benchmark so this sorts like the 7d change
+			// once the benchmark is loaded. Until then every coin is equal and keeps the rank order in both orders
+			if _, ok := ct.RelativeStrength(a); !ok {
+				if ct.State.sortDesc {
+					return b.Rank < a.Rank
+				}
+				return a.Rank < b.Rank
+			}
+			return a.PercentChange7D < b.PercentChange7D
+		case "rank_change":
+			// unknown changes sort below every known change
+			ca, oka := ct.RankChange(a)
+			cb, okb := ct.RankChange(b)
+			if oka != okb {
+				return okb
+			}
+			return ca < cb
 		case "last_updated":
 			return a.LastUpdated < b.LastUpdated
+		case "date_added":
+			return a.DateAdded < b.DateAdded
 		default:
 			return a.Rank < b.Rank
 		}
@@ -0,0 +1,107 @@
+package cointop
+
+import (
+	"testing"
+)
+
+// TestSortUnrankedCoins checks that coins without a rank are ordered by market cap and then name
+func TestSortUnrankedCoins(t *testing.T) {
+	want := []string{"Bitcoin", "Delta", "Alpha", "Charlie", "Bravo"}
+	for attempt := 0; attempt < 10; attempt++ {
+		coins := []*Coin{
+			{Name: "Charlie", MarketCap: 100},
+			{Name: "Alpha", MarketCap: 100},
+			{Name: "Bravo", MarketCap: 0},
+			{Name: "Bitcoin", Rank: 1, MarketCap: 5},
+			{Name: "Delta", MarketCap: 200},
+		}
+		// rotate the input so the result doesn't depend on the original order
+		coins = append(coins[attempt%len(coins):], coins[:attempt%len(coins)]...)
+
+		var unranked []*Coin
+		for _, coin := range coins {
+			if coin.Rank == 0 {
+				unranked = append(unranked, coin)
+			}
+		}
+		RankUnrankedCoins(unranked)
+
+		ct := &Cointop{State: &State{}}
+		ct.Sort("rank", false, coins, false)
+		for i, coin := range coins {
+			if coin.Name != want[i] {
+				t.Fatalf("attempt %d: coins[%d] == %q, want %q", attempt, i, coin.Name, want[i])
+			}
+		}
+		if coins[1].Rank != UnrankedCoinRank {
+			t.Errorf("rank == %d, want %d", coins[1].Rank, UnrankedCoinRank)
+		}
+	}
+}
+
+// TestSortEqualRank checks that coins sharing a rank sort the same regardless of input order
+func TestSortEqualRank(t *testing.T) {
+	want := []string{"Delta", "Alpha", "Charlie"}
+	for attempt := 0; attempt < 3; attempt++ {
+		coins := []*Coin{
+			{Name: "Charlie", Rank: UnrankedCoinRank, MarketCap: 100},
+			{Name: "Alpha", Rank: UnrankedCoinRank, MarketCap: 100},
+			{Name: "Delta", Rank: UnrankedCoinRank, MarketCap: 200},
+		}
+		coins = append(coins[attempt:], coins[:attempt]...)
+
+		ct := &Cointop{State: &State{}}
+		ct.Sort("rank", false, coins, false)
+		for i, coin := range coins {
+			if coin.Name != want[i] {
+				t.Fatalf("attempt %d: coins[%d] == %q, want %q", attempt, i, coin.Name, want[i])
+			}
+		}
+	}
+}
+
+// TestSortVolumeMarketCap checks that coins without a market cap sort last in both orders
+func TestSortVolumeMarketCap(t *testing.T) {
+	tests := []struct {
+		desc bool
+		want []string
+	}{
+		{false, []string{"Alpha", "Bravo", "Charlie"}},
+		{true, []string{"Bravo", "Alpha", "Charlie"}},
+	}
+	for _, tt := range tests {
+		coins := []*Coin{
+			{Name: "Charlie", Volume24H: 50, MarketCap: 0},
+			{Name: "Bravo", Volume24H: 50, MarketCap: 100},
+			{Name: "Alpha", Volume24H: 10, MarketCap: 100},
+		}
+
+		ct := &Cointop{State: &State{}}
+		ct.Sort("volume_mcap", tt.desc, coins, false)
+		for i, coin := range coins {
+			if coin.Name != tt.want[i] {
+				t.Fatalf("desc %v: coins[%d] == %q, want %q", tt.desc, i, coin.Name, tt.want[i])
+			}
+		}
+	}
+}
+
+// TestSortRelativeStrengthNoBenchmark checks that the coins keep the rank order in both orders until the benchmark is loaded
+func TestSortRelativeStrengthNoBenchmark(t *testing.T) {
+	for _, desc := range []bool{false, true} {
+		coins := []*Coin{
+			{Name: "Charlie", Rank: 3, PercentChange7D: 5},
+			{Name: "Alpha", Rank: 1, PercentChange7D: -2},
+			{Name: "Bravo", Rank: 2, PercentChange7D: 9},
+		}
+
+		ct := &Cointop{State: &State{benchmarkCoin: "Bitcoin"}}
+		ct.Sort("rs_btc", desc, coins, false)
+		want := []string{"Alpha", "Bravo", "Charlie"}
+		for i, coin := range coins {
+			if coin.Name != want[i] {
+				t.Fatalf("desc %v: coins[%d] == %q, want %q", desc, i, coin.Name, want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package cointop
+
+import (
+	"github.com/miguelmota/cointop/pkg/api"
+)
+
+// SparklineWidth is the number of characters of the sparkline column
+const SparklineWidth = 14
+
+// sparklineRunes are the block characters of the sparkline from lowest to highest
+var sparklineRunes = []rune("▁▂▃▄▅▆▇█")
+
+// RenderSparkline returns the values as a line of block characters at most the width long. The values are
+// averaged into one bucket per character when there are more values than characters
+func RenderSparkline(values []float64, width int) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+	if len(values) < width {
+		width = len(values)
+	}
+
+	buckets := make([]float64, width)
+	for i := range buckets {
+		start := i * len(values) / width
+		end := (i + 1) * len(values) / width
+		var sum float64
+		for _, v := range values[start:end] {
+			sum += v
+		}
+		buckets[i] = sum / float64(end-start)
+	}
+
+	min, max := buckets[0], buckets[0]
+	for _, v := range buckets {
+		if v < min {
+			min = v
+		}
+		if v > max {
+			max = v
+		}
+	}
+
+	runes := make([]rune, width)
+	for i, v := range buckets {
+		idx := 0
+		if max > min {
+			idx = int((v-min)/(max-min)*float64(len(sparklineRunes)-1) + 0.5)
+		}
+		runes[i] = sparklineRunes[idx]
+	}
+	return string(runes)
+}
+
+// IsSparklineVisible returns true if the coins or favorites table shows the sparkline column
+func (ct *Cointop) IsSparklineVisible() bool {
+	for _, columns := range [][]string{ct.State.coinsTableColumns, ct.State.favoritesTableColumns} {
+		for _, col := range columns {
+			if col == "sparkline_7d" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// syncAPISparkline requests the sparkline data from the API only while a table shows the sparkline column,
+// since it makes the responses larger
+func (ct *Cointop) syncAPISparkline(client api.Interface) {
+	if s, ok := client.(api.SparklineInterface); ok {
+		s.SetSparkline(ct.IsSparklineVisible())
+	}
+}
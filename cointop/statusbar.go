@@ -26,7 +26,7 @@ func (ct *Cointop) UpdateStatusbar(s string) error {
 	var quitText string
 	var favoritesText string
 	var portfolioText string
-	if ct.IsPortfolioVisible() || ct.IsFavoritesVisible() {
+	if ct.IsPortfolioVisible() || ct.IsFavoritesVisible() || ct.IsRecentlyAddedVisible() || ct.IsMoversVisible() {
 		quitText = "Return"
 	} else {
 		quitText = "Quit"
@@ -52,6 +52,20 @@ func (ct *Cointop) UpdateStatusbar(s string) error {
 		content = fmt.Sprintf("%s %s[+]Add", helpStr, editStr)
 	} else {
 		base := fmt.Sprintf("%s %sChart %sRange %sSearch %sConvert %s %s", helpStr, "[Enter]", "[[ ]]", "[/]", "[C]", favoritesText, portfolioText)
+		if ct.IsOffline() {
+			base = fmt.Sprintf("%s %s", base, OfflineStatus)
+		}
+		if ct.IsDemoMode() {
+			base = fmt.Sprintf("%s %s", base, DemoStatus)
+		}
+		if ct.IsRefreshPaused() {
+			base = fmt.Sprintf("%s %s", base, PausedStatus)
+		}
+		if ct.State.favoritesSummary {
+			if count, change := ct.FavoritesSummary(); count > 0 {
+				base = fmt.Sprintf("%s %s%d %+.2f%%", base, FavoriteStar, count, change)
+			}
+		}
 		str := pad.Right(fmt.Sprintf("%v %sPage %v/%v %s", base, "[← →]", currpage, totalpages, s), ct.width(), " ")
 		v := ct.Version()
 		size := utf8.RuneCountInString(str)
@@ -78,8 +92,13 @@ func (ct *Cointop) RefreshRowLink() error {
 		shortcut = "[O]Open "
 	}
 
+	var apiText string
+	if ct.IsAPIFallbackActive() {
+		apiText = fmt.Sprintf("[%s] ", ct.ActiveAPIChoice())
+	}
+
 	url := ct.RowLinkShort()
-	ct.UpdateStatusbar(fmt.Sprintf("%s%s", shortcut, url))
+	ct.UpdateStatusbar(fmt.Sprintf("%s%s%s", apiText, shortcut, url))
 
 	return nil
 }
@@ -25,16 +25,35 @@ func TableColumnOrder() []string {
 		"name",
 		"symbol",
 		"price",
+		"24h_high",
+		"24h_low",
 		"holdings",
 		"balance",
 		"market_cap",
+		"fdv",
+		"fdv_mcap",
+		"volume_mcap",
+		"ath",
+		"ath_change",
 		"24h_volume",
 		"1h_change",
 		"7d_change",
+		"sparkline_7d",
+		"change",
 		"total_supply",
 		"available_supply",
+		"circ_pct",
+		"max_supply",
+		"circ_max_pct",
+		"rs_btc",
+		"rank_change",
 		"percent_holdings",
+		"cost_price",
+		"cost",
+		"pnl",
+		"pnl_percent",
 		"last_updated",
+		"date_added",
 	}
 }
 
@@ -56,6 +75,11 @@ func (ct *Cointop) RefreshTable() error {
 		if ct.table.RowCount() == 0 {
 			statusText = "No price alerts found. Press \"+\" on a coin to add a price alert."
 		}
+	case RecentlyAddedView:
+		ct.table = ct.GetCoinsTable()
+		if ct.table.RowCount() == 0 {
+			statusText = "No recently added coins found."
+		}
 	default:
 		ct.table = ct.GetCoinsTable()
 		if ct.table.RowCount() == 0 {
@@ -98,9 +122,12 @@ func (ct *Cointop) UpdateTable() error {
 		ct.State.coins = ct.GetFavoritesSlice()
 	} else if ct.IsPortfolioVisible() {
 		ct.State.coins = ct.GetPortfolioSlice()
+	} else if ct.IsRecentlyAddedVisible() {
+		ct.State.coins = ct.GetRecentlyAddedSlice()
+	} else if ct.IsMoversVisible() {
+		ct.State.coins = ct.GetMoversSlice(ct.IsLosersVisible())
 	} else {
-		// TODO: maintain state of previous sorting
-		if ct.State.sortBy == "holdings" {
+		if ct.State.sortBy == "holdings" || ct.State.sortBy == "date_added" {
 			ct.State.sortBy = "rank"
 			ct.State.sortDesc = false
 		}
@@ -109,6 +136,9 @@ func (ct *Cointop) UpdateTable() error {
 	}
 
 	ct.Sort(ct.State.sortBy, ct.State.sortDesc, ct.State.coins, true)
+	if ct.IsFavoritesVisible() && ct.HasFavoriteGroups() {
+		ct.SectionFavorites(ct.State.coins)
+	}
 	go ct.RefreshTable()
 	return nil
 }
@@ -120,6 +150,9 @@ func (ct *Cointop) GetTableCoinsSlice() []*Coin {
 	start := ct.State.page * ct.State.perPage
 	end := start + ct.State.perPage
 	allCoins := ct.AllCoins()
+	if ct.IsCoinsFiltered() {
+		return ct.filteredCoinsSlice(allCoins)
+	}
 	size := len(allCoins)
 	if start < 0 {
 		start = 0
@@ -202,7 +235,7 @@ func (ct *Cointop) RowLink() string {
 		return ""
 	}
 
-	return ct.api.CoinLink(coin.Name)
+	return ct.API().CoinLink(coin.Name)
 }
 
 // RowLinkShort returns a shortened version of the row url link
@@ -259,13 +292,14 @@ func (ct *Cointop) ToggleTableFullscreen() error {
 
 // SetSelectedView sets the active table view
 func (ct *Cointop) SetSelectedView(viewName string) {
+	ct.SwapViewSort(ct.State.selectedView, viewName)
 	ct.State.lastSelectedView = ct.State.selectedView
 	ct.State.selectedView = viewName
 }
 
 // ToggleSelectedView toggles between current table view and last selected table view
 func (ct *Cointop) ToggleSelectedView(viewName string) {
-	if !(ct.IsPortfolioVisible() || ct.IsFavoritesVisible()) {
+	if !(ct.IsPortfolioVisible() || ct.IsFavoritesVisible() || ct.IsRecentlyAddedVisible() || ct.IsMoversVisible()) {
 		ct.State.lastSelectedRowIndex = ct.HighlightedPageRowIndex()
 	}
 	if ct.State.lastSelectedView == "" || ct.State.selectedView != viewName {
@@ -275,7 +309,7 @@ func (ct *Cointop) ToggleSelectedView(viewName string) {
 	}
 
 	l := ct.TableRowsLen()
-	if ct.IsPortfolioVisible() || ct.IsFavoritesVisible() {
+	if ct.IsPortfolioVisible() || ct.IsFavoritesVisible() || ct.IsRecentlyAddedVisible() || ct.IsMoversVisible() {
 		// highlight last row if current row is out of bounds (can happen when switching views).
 		currentRowIdx := ct.HighlightedRowIndex()
 		if currentRowIdx >= l-1 {
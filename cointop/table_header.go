@@ -16,6 +16,9 @@ var ArrowUp = "▲"
 // ArrowDown is down arrow unicode character
 var ArrowDown = "▼"
 
+// VolumeInCoinLabel is appended to the volume column label when the volume is in units of the coin
+var VolumeInCoinLabel = " (coins)"
+
 // HeaderColumn is header column struct
 type HeaderColumn struct {
 	Slug       string
@@ -35,6 +38,11 @@ var HeaderColumns = map[string]*HeaderColumn{
 		Label:      "[n]ame",
 		PlainLabel: "name",
 	},
+	"group": &HeaderColumn{
+		Slug:       "group",
+		Label:      "group",
+		PlainLabel: "group",
+	},
 	"symbol": &HeaderColumn{
 		Slug:       "symbol",
 		Label:      "[s]ymbol",
@@ -90,6 +98,11 @@ var HeaderColumns = map[string]*HeaderColumn{
 		Label:      "[7]D%",
 		PlainLabel: "7D%",
 	},
+	"sparkline_7d": &HeaderColumn{
+		Slug:       "sparkline_7d",
+		Label:      "7D trend",
+		PlainLabel: "7D trend",
+	},
 	"30d_change": &HeaderColumn{
 		Slug:       "30d_change",
 		Label:      "[3]0D%",
@@ -105,16 +118,106 @@ var HeaderColumns = map[string]*HeaderColumn{
 		Label:      "[a]vailable supply",
 		PlainLabel: "available supply",
 	},
+	"circ_pct": &HeaderColumn{
+		Slug:       "circ_pct",
+		Label:      "circ%",
+		PlainLabel: "circ%",
+	},
+	"max_supply": &HeaderColumn{
+		Slug:       "max_supply",
+		Label:      "max supply",
+		PlainLabel: "max supply",
+	},
+	"circ_max_pct": &HeaderColumn{
+		Slug:       "circ_max_pct",
+		Label:      "circ% max",
+		PlainLabel: "circ% max",
+	},
+	"change": &HeaderColumn{
+		Slug:       "change",
+		Label:      "change",
+		PlainLabel: "change",
+	},
+	"fdv": &HeaderColumn{
+		Slug:       "fdv",
+		Label:      "fdv",
+		PlainLabel: "fdv",
+	},
+	"fdv_mcap": &HeaderColumn{
+		Slug:       "fdv_mcap",
+		Label:      "fdv/mcap",
+		PlainLabel: "fdv/mcap",
+	},
+	"volume_mcap": &HeaderColumn{
+		Slug:       "volume_mcap",
+		Label:      "vol/mcap",
+		PlainLabel: "vol/mcap",
+	},
+	"24h_high": &HeaderColumn{
+		Slug:       "24h_high",
+		Label:      "24H high",
+		PlainLabel: "24H high",
+	},
+	"24h_low": &HeaderColumn{
+		Slug:       "24h_low",
+		Label:      "24H low",
+		PlainLabel: "24H low",
+	},
+	"ath": &HeaderColumn{
+		Slug:       "ath",
+		Label:      "ATH",
+		PlainLabel: "ATH",
+	},
+	"ath_change": &HeaderColumn{
+		Slug:       "ath_change",
+		Label:      "from ATH%",
+		PlainLabel: "from ATH%",
+	},
+	"rs_btc": &HeaderColumn{
+		Slug:       "rs_btc",
+		Label:      "rel strength",
+		PlainLabel: "rel strength",
+	},
+	"rank_change": &HeaderColumn{
+		Slug:       "rank_change",
+		Label:      "rank chg",
+		PlainLabel: "rank chg",
+	},
 	"percent_holdings": &HeaderColumn{
 		Slug:       "percent_holdings",
 		Label:      "[%]holdings",
 		PlainLabel: "%holdings",
 	},
+	"cost_price": &HeaderColumn{
+		Slug:       "cost_price",
+		Label:      "cost price",
+		PlainLabel: "cost price",
+	},
+	"cost": &HeaderColumn{
+		Slug:       "cost",
+		Label:      "cost",
+		PlainLabel: "cost",
+	},
+	"pnl": &HeaderColumn{
+		Slug:       "pnl",
+		Label:      "PNL",
+		PlainLabel: "PNL",
+	},
+	"pnl_percent": &HeaderColumn{
+		Slug:       "pnl_percent",
+		Label:      "PNL%",
+		PlainLabel: "PNL%",
+	},
 	"last_updated": &HeaderColumn{
 		Slug:       "last_updated",
 		Label:      "last [u]pdated",
 		PlainLabel: "last updated",
 	},
+	"date_added": &HeaderColumn{
+		Slug:       "date_added",
+		Label:      "date added",
+		PlainLabel: "date added",
+	},
 }
 
 // TableHeaderView is structure for table header view
@@ -132,19 +235,11 @@ func (ct *Cointop) UpdateTableHeader() error {
 
 	baseColor := ct.colorscheme.TableHeaderSprintf()
 	noSort := ct.IsPriceAlertsVisible()
-	var cols []string
-	switch ct.State.selectedView {
-	case PortfolioView:
-		cols = ct.GetPortfolioTableHeaders()
-	case PriceAlertsView:
-		cols = ct.GetPriceAlertsTableHeaders()
-	default:
-		cols = ct.GetCoinsTableHeaders()
-	}
+	cols := ct.GetActiveTableHeaders()
 
 	var headers []string
 	for i, col := range cols {
-		hc, ok := HeaderColumns[col]
+		hc, ok := headerColumn(col)
 		if !ok {
 			continue
 		}
@@ -170,8 +265,18 @@ func (ct *Cointop) UpdateTableHeader() error {
 		}
 		leftAlign := ct.GetTableColumnAlignLeft(col)
 		switch col {
-		case "price", "balance":
-			label = ct.CurrencySymbol() + label
+		case "price", "24h_high", "24h_low", "ath", "balance", "cost_price", "cost", "pnl":
+			if ct.IsPortfolioVisible() {
+				label = ct.PortfolioCurrencySymbol() + label
+			} else {
+				label = ct.CurrencySymbol() + label
+			}
+		case "change":
+			label = ct.State.changeWindow + " " + label
+		case "24h_volume":
+			if ct.State.volumeInCoin {
+				label = label + VolumeInCoinLabel
+			}
 		}
 		if leftAlign {
 			label = label + arrow
@@ -202,6 +307,56 @@ func (ct *Cointop) UpdateTableHeader() error {
 	return nil
 }
 
+// GetActiveTableHeaders returns the header columns of the selected table view
+func (ct *Cointop) GetActiveTableHeaders() []string {
+	switch ct.State.selectedView {
+	case PortfolioView:
+		return ct.GetPortfolioTableHeaders()
+	case PriceAlertsView:
+		return ct.GetPriceAlertsTableHeaders()
+	case RecentlyAddedView:
+		return ct.GetRecentlyAddedTableHeaders()
+	case FavoritesView:
+		return ct.GetFavoritesTableHeaders()
+	default:
+		return ct.GetCoinsTableHeaders()
+	}
+}
+
+// ToggleRankColumn shows or hides the rank column of the active view
+func (ct *Cointop) ToggleRankColumn() error {
+	ct.debuglog("toggleRankColumn()")
+	switch ct.State.selectedView {
+	case PortfolioView:
+		ct.State.portfolioTableColumns = toggleColumn(ct.State.portfolioTableColumns, "rank")
+	case FavoritesView:
+		ct.State.favoritesTableColumns = toggleColumn(ct.State.favoritesTableColumns, "rank")
+	case CoinsView:
+		ct.State.coinsTableColumns = toggleColumn(ct.State.coinsTableColumns, "rank")
+	default:
+		ct.UpdateStatusbar("Rank column can't be toggled in this view")
+		return nil
+	}
+
+	go ct.UpdateTable()
+	return ct.Save()
+}
+
+// toggleColumn returns a copy of the columns with the column removed, or prepended if missing
+func toggleColumn(columns []string, name string) []string {
+	toggled := make([]string, 0, len(columns)+1)
+	for _, col := range columns {
+		if col != name {
+			toggled = append(toggled, col)
+		}
+	}
+	if len(toggled) == len(columns) {
+		toggled = append([]string{name}, toggled...)
+	}
+
+	return toggled
+}
+
 // SetTableColumnAlignLeft sets the column alignment direction for header
 func (ct *Cointop) SetTableColumnAlignLeft(header string, alignLeft bool) {
 	ct.State.tableColumnAlignLeft.Store(header, alignLeft)
@@ -223,11 +378,19 @@ func (ct *Cointop) SetTableColumnWidth(header string, width int) {
 	if ok {
 		prev = prevIfc.(int)
 	} else {
-		hc := HeaderColumns[header]
-		prev = utf8.RuneCountInString(hc.Label) + 1
+		hc, ok := headerColumn(header)
+		if ok {
+			prev = utf8.RuneCountInString(hc.Label) + 1
+		}
 		switch header {
-		case "price", "balance":
+		case "price", "ath", "balance", "cost_price", "cost", "pnl":
 			prev++
+		case "change":
+			prev += utf8.RuneCountInString(ct.State.changeWindow) + 1
+		case "24h_volume":
+			if ct.State.volumeInCoin {
+				prev += utf8.RuneCountInString(VolumeInCoinLabel)
+			}
 		}
 	}
 
@@ -0,0 +1,50 @@
+package cointop
+
+// saveTablePosition stores the page and highlighted row of the coins table to restore them on the next start.
+// The position of the other views isn't stored since it's lost when switching views
+func (ct *Cointop) saveTablePosition() error {
+	ct.debuglog("saveTablePosition()")
+	if ct.State.selectedView != CoinsView || ct.Views.Table.Backing() == nil {
+		return nil
+	}
+
+	ct.State.lastSelectedRowIndex = ct.HighlightedRowIndex()
+	ct.State.savedPage = ct.State.page
+	ct.State.savedRowIndex = ct.State.lastSelectedRowIndex
+	return ct.SaveConfig()
+}
+
+// restoreTablePosition highlights the coins table row that was highlighted on exit.
+// A position past the end of the list is clamped to the last row
+func (ct *Cointop) restoreTablePosition() error {
+	ct.debuglog("restoreTablePosition()")
+	if ct.State.positionRestored {
+		return nil
+	}
+	ct.State.positionRestored = true
+	if ct.State.selectedView != CoinsView || ct.TableRowsLen() == 0 {
+		return nil
+	}
+
+	idx := ct.State.savedPage*ct.State.perPage + ct.State.savedRowIndex
+	if max := ct.GetListCount() - 1; idx > max {
+		idx = max
+	}
+	if idx <= 0 {
+		return nil
+	}
+
+	ct.State.lastSelectedRowIndex = ct.State.savedRowIndex
+	return ct.GoToGlobalIndex(idx)
+}
+
+// loadTablePositionFromConfig loads the saved coins table position from the table config to struct
+func (ct *Cointop) loadTablePositionFromConfig() error {
+	if page, ok := ct.config.Table["last_page"].(int64); ok && page >= 0 {
+		ct.State.savedPage = int(page)
+	}
+	if rowIndex, ok := ct.config.Table["last_row_index"].(int64); ok && rowIndex >= 0 {
+		ct.State.savedRowIndex = int(rowIndex)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package cointop
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/miguelmota/cointop/pkg/humanize"
+)
+
+// NOTE: the title stack sequences are ignored by terminals that don't support them
+const (
+	terminalTitleSave    = "\033[22;0t"
+	terminalTitleRestore = "\033[23;0t"
+	terminalTitleFormat  = "\033]0;%s\007"
+)
+
+// TerminalTitleSupported returns true if the terminal title can be set
+func (ct *Cointop) TerminalTitleSupported() bool {
+	if !ct.State.terminalTitle {
+		return false
+	}
+	term := os.Getenv("TERM")
+	return term != "" && term != "dumb"
+}
+
+// SaveTerminalTitle saves the current terminal title so it can be restored on exit
+func (ct *Cointop) SaveTerminalTitle() {
+	if !ct.TerminalTitleSupported() {
+		return
+	}
+	fmt.Fprint(os.Stdout, terminalTitleSave)
+}
+
+// RestoreTerminalTitle restores the terminal title saved on start
+func (ct *Cointop) RestoreTerminalTitle() {
+	if !ct.TerminalTitleSupported() {
+		return
+	}
+	fmt.Fprint(os.Stdout, terminalTitleRestore)
+}
+
+// UpdateTerminalTitle sets the terminal title to the highlighted coin and its price
+func (ct *Cointop) UpdateTerminalTitle() {
+	if !ct.TerminalTitleSupported() {
+		return
+	}
+	coin := ct.HighlightedRowCoin()
+	if coin == nil {
+		return
+	}
+
+	title := fmt.Sprintf("%s %s%s", coin.Symbol, ct.CurrencySymbol(), humanize.Commaf(coin.Price))
+	if title == ct.State.lastTerminalTitle {
+		return
+	}
+	ct.State.lastTerminalTitle = title
+
+	// NOTE: written on the main loop so it doesn't interleave with the screen drawing
+	ct.UpdateUI(func() error {
+		fmt.Fprintf(os.Stdout, terminalTitleFormat, title)
+		return nil
+	})
+}
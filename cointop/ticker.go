@@ -0,0 +1,215 @@
+package cointop
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/miguelmota/cointop/pkg/humanize"
+	"github.com/miguelmota/cointop/pkg/pad"
+	"github.com/miguelmota/cointop/pkg/ui"
+)
+
+// TickerScrollInterval is the time between each scroll step of the ticker
+var TickerScrollInterval = 300 * time.Millisecond
+
+// tickerSeparator separates the coins in the ticker
+const tickerSeparator = "   •   "
+
+// TickerView is structure for the ticker view
+type TickerView = ui.View
+
+// NewTickerView returns a new ticker view
+func NewTickerView() *TickerView {
+	var view *TickerView = ui.NewView("ticker")
+	return view
+}
+
+// tickerSegment is a piece of the ticker text drawn in a single color
+type tickerSegment struct {
+	text  string
+	color func(s string) string
+}
+
+// TickerHeight returns the height of the ticker, zero if it's hidden
+func (ct *Cointop) TickerHeight() int {
+	if !ct.State.tickerVisible || ct.State.onlyTable {
+		return 0
+	}
+	return 1
+}
+
+// ToggleTicker shows or hides the ticker of the favorites
+func (ct *Cointop) ToggleTicker() error {
+	ct.debuglog("toggleTicker()")
+	ct.State.tickerVisible = !ct.State.tickerVisible
+	atomic.StoreInt32(&ct.State.tickerOffset, 0)
+	if err := ct.Save(); err != nil {
+		return err
+	}
+
+	go ct.UpdateTicker()
+	return nil
+}
+
+// loadTickerFromConfig loads the ticker setting from config file to struct
+func (ct *Cointop) loadTickerFromConfig() error {
+	ct.debuglog("loadTickerFromConfig()")
+	if ticker, ok := ct.config.Ticker.(bool); ok {
+		ct.State.tickerVisible = ticker
+	}
+
+	return nil
+}
+
+// TickerWatcher scrolls the ticker while it's visible and the favorites don't fit
+func (ct *Cointop) TickerWatcher() {
+	ct.debuglog("tickerWatcher()")
+	ticker := time.NewTicker(TickerScrollInterval)
+	for range ticker.C {
+		if ct.TickerHeight() == 0 {
+			continue
+		}
+		ct.updateTicker(true)
+	}
+}
+
+// tickerSegments returns the symbol, price and 24 hour change of the favorites ordered by rank
+func (ct *Cointop) tickerSegments() []*tickerSegment {
+	var coins []*Coin
+	coinslock.Lock()
+	for _, coin := range ct.State.allCoins {
+		if coin.Favorite {
+			coins = append(coins, coin)
+		}
+	}
+	coinslock.Unlock()
+	sort.Slice(coins, func(i, j int) bool {
+		return coins[i].Rank < coins[j].Rank
+	})
+
+	plain := func(s string) string {
+		return ct.colorscheme.Marketbar(s)
+	}
+	up := func(s string) string {
+		return ct.colorscheme.MarketbarChangeUpSprintf()("%s", s)
+	}
+	down := func(s string) string {
+		return ct.colorscheme.MarketbarChangeDownSprintf()("%s", s)
+	}
+
+	var segments []*tickerSegment
+	for _, coin := range coins {
+		color := plain
+		arrow := ""
+		if coin.PercentChange24H > 0 {
+			color = up
+			arrow = ArrowUp
+		}
+		if coin.PercentChange24H < 0 {
+			color = down
+			arrow = ArrowDown
+		}
+		segments = append(segments,
+			&tickerSegment{
+				text:  fmt.Sprintf("%s %s%s ", coin.Symbol, ct.CurrencySymbol(), ct.FormatPrice(coin.Price)),
+				color: plain,
+			},
+			&tickerSegment{
+				text:  fmt.Sprintf("%s%s", arrow, humanize.Percentf(coin.PercentChange24H, 2)),
+				color: color,
+			},
+			&tickerSegment{
+				text:  tickerSeparator,
+				color: plain,
+			})
+	}
+	return segments
+}
+
+// UpdateTicker updates the ticker with the favorites scrolled by the ticker offset
+func (ct *Cointop) UpdateTicker() error {
+	ct.debuglog("updateTicker()")
+	return ct.updateTicker(false)
+}
+
+// updateTicker updates the ticker, first moving it a step if scroll is true. Scrolling leaves the ticker as is
+// when the favorites fit since there's nothing to move
+func (ct *Cointop) updateTicker(scroll bool) error {
+	if ct.TickerHeight() == 0 {
+		return nil
+	}
+
+	width := ct.width()
+	segments := ct.tickerSegments()
+
+	// NOTE: each rune keeps the index of its segment so the visible window can be colored by segment
+	var runes []rune
+	var owners []int
+	for i, segment := range segments {
+		for _, r := range segment.text {
+			runes = append(runes, r)
+			owners = append(owners, i)
+		}
+	}
+
+	if scroll && len(runes) <= width {
+		return nil
+	}
+
+	var content string
+	switch {
+	case len(runes) == 0:
+		content = ct.colorscheme.Marketbar(pad.Right(" No favorites to show. Press f to add the highlighted coin to favorites", width, " "))
+	case len(runes) <= width:
+		// NOTE: the favorites fit so they're shown without scrolling or the trailing separator
+		n := len(runes) - len([]rune(tickerSeparator))
+		content = ct.tickerWindow(runes[:n], owners[:n], segments, 0, width)
+	default:
+		var offset int32
+		if scroll {
+			offset = atomic.AddInt32(&ct.State.tickerOffset, 1)
+		} else {
+			offset = atomic.LoadInt32(&ct.State.tickerOffset)
+		}
+		offset %= int32(len(runes))
+		content = ct.tickerWindow(runes, owners, segments, int(offset), width)
+	}
+
+	ct.UpdateUI(func() error {
+		return ct.Views.Ticker.Update(content)
+	})
+
+	return nil
+}
+
+// tickerWindow returns the colored text of width runes starting at offset, wrapping around to the start
+func (ct *Cointop) tickerWindow(runes []rune, owners []int, segments []*tickerSegment, offset int, width int) string {
+	var b strings.Builder
+	var chunk []rune
+	owner := -1
+	flush := func() {
+		if len(chunk) > 0 {
+			b.WriteString(segments[owner].color(string(chunk)))
+		}
+		chunk = chunk[:0]
+	}
+	for i := 0; i < width; i++ {
+		k := (offset + i) % len(runes)
+		if i >= len(runes) {
+			// NOTE: text shorter than the width is padded instead of repeated
+			flush()
+			b.WriteString(ct.colorscheme.Marketbar(strings.Repeat(" ", width-i)))
+			return b.String()
+		}
+		if owners[k] != owner {
+			flush()
+			owner = owners[k]
+		}
+		chunk = append(chunk, runes[k])
+	}
+	flush()
+	return b.String()
+}
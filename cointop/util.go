@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/miguelmota/cointop/pkg/clipboard"
 	"github.com/miguelmota/cointop/pkg/open"
 )
 
@@ -18,6 +19,23 @@ func (ct *Cointop) OpenLink() error {
 	return nil
 }
 
+// CopyRowLink copies the url of the highlighted coin to the clipboard
+func (ct *Cointop) CopyRowLink() error {
+	ct.debuglog("copyRowLink()")
+	link := ct.RowLink()
+	if link == "" {
+		return nil
+	}
+
+	if err := clipboard.Copy(link); err != nil {
+		ct.UpdateStatusbar(fmt.Sprintf("Could not copy link: %s", err))
+		return nil
+	}
+
+	ct.UpdateStatusbar(fmt.Sprintf("Copied %s link to clipboard", ct.HighlightedRowCoin().Symbol))
+	return nil
+}
+
 // GetBytes returns the interface in bytes form
 func GetBytes(key interface{}) ([]byte, error) {
 	var buf bytes.Buffer
@@ -52,6 +70,14 @@ func (ct *Cointop) ClearSyncMap(syncMap sync.Map) {
 	})
 }
 
+// ansiRegexp matches the ANSI color escape codes
+var ansiRegexp = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// stripANSI removes ANSI color escape codes from a string
+func stripANSI(s string) string {
+	return ansiRegexp.ReplaceAllString(s, "")
+}
+
 // NormalizeFloatString normalizes a float as a string
 func normalizeFloatString(input string) string {
 	re := regexp.MustCompile(`(\d+\.\d+|\.\d+|\d+)`)
@@ -8,6 +8,7 @@ require (
 	github.com/fatih/color v1.9.0
 	github.com/gen2brain/beeep v0.0.0-20200526185328-e9c15c258e28
 	github.com/gliderlabs/ssh v0.3.0
+	github.com/gorilla/websocket v1.4.2
 	github.com/maruel/panicparse v1.5.0
 	github.com/mattn/go-colorable v0.1.7 // indirect
 	github.com/mattn/go-runewidth v0.0.9
@@ -3,11 +3,16 @@ package api
 import (
 	cg "github.com/miguelmota/cointop/pkg/api/impl/coingecko"
 	cmc "github.com/miguelmota/cointop/pkg/api/impl/coinmarketcap"
+	cp "github.com/miguelmota/cointop/pkg/api/impl/coinpaprika"
+	demo "github.com/miguelmota/cointop/pkg/api/impl/demo"
 )
 
-// NewCMC new CoinMarketCap API
-func NewCMC(apiKey string) Interface {
-	return cmc.NewCMC(apiKey)
+// NewCMC new CoinMarketCap API. An empty base URL uses the production Pro API.
+func NewCMC(apiKey string, baseURL string) Interface {
+	return cmc.NewCMC(&cmc.Config{
+		APIKey:  apiKey,
+		BaseURL: baseURL,
+	})
 }
 
 // NewCC new CryptoCompare API
@@ -15,7 +20,24 @@ func NewCC() {
 	// TODO
 }
 
-// NewCG new CoinGecko API
-func NewCG() Interface {
-	return cg.NewCoinGecko()
+// NewCG new CoinGecko API. Chart auto interval requests the chart data interval based on the chart range.
+// Max pages is the number of pages of coins to fetch and batch concurrency is the number of requests of a batch
+// fetch made at the same time, zero uses the defaults.
+func NewCG(chartAutoInterval bool, maxPages int, idOverrides map[string]string, batchConcurrency int) Interface {
+	return cg.NewCoinGecko(&cg.Config{
+		ChartAutoInterval: chartAutoInterval,
+		MaxPages:          maxPages,
+		IDOverrides:       idOverrides,
+		BatchConcurrency:  batchConcurrency,
+	})
+}
+
+// NewCP new CoinPaprika API
+func NewCP() Interface {
+	return cp.NewCoinPaprika()
+}
+
+// NewDemo new demo API of fixed coin data that doesn't make any requests
+func NewDemo() Interface {
+	return demo.NewDemo()
 }
@@ -3,9 +3,11 @@ package coingecko
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	apitypes "github.com/miguelmota/cointop/pkg/api/types"
@@ -20,27 +22,97 @@ var ErrPingFailed = errors.New("failed to ping")
 // ErrNotFound is the error when the target is not found
 var ErrNotFound = errors.New("not found")
 
+// ErrPaidPlanRequired is the error when the recently added coins are requested without a paid API plan
+var ErrPaidPlanRequired = errors.New("recently added coins require a paid CoinGecko API plan")
+
+// DefaultMaxPages is the default number of coin pages fetched
+const DefaultMaxPages = 10
+
+// MaxPagesLimit is the highest number of coin pages that can be fetched
+const MaxPagesLimit = 60
+
+// DefaultBatchConcurrency is the default number of requests of a batch fetch made at the same time
+const DefaultBatchConcurrency = 1
+
+// MaxBatchConcurrency is the highest number of requests of a batch fetch made at the same time
+const MaxBatchConcurrency = 4
+
+// batchInterval is the min time between starting the requests of a batch fetch, to keep within the API rate limit
+var batchInterval = 1 * time.Second
+
+// Config config
+type Config struct {
+	// ChartAutoInterval requests the chart data interval based on the chart range
+	ChartAutoInterval bool
+	// MaxPages is the number of pages of 250 coins fetched. Zero uses the default
+	MaxPages int
+	// IDOverrides maps coin names or symbols to coin IDs, taking precedence over the IDs looked up from the coin list
+	IDOverrides map[string]string
+	// BatchConcurrency is the number of requests of a batch fetch made at the same time. Zero uses the default
+	BatchConcurrency int
+}
+
 // Service service
 type Service struct {
 	client            *gecko.Client
 	maxResultsPerPage int
 	maxPages          int
+	chartAutoInterval bool
+	idOverrides       map[string]string
+	batchConcurrency  int
+	limiter           <-chan time.Time
+	sparkline         int32
 	cacheMap          sync.Map
 }
 
 // NewCoinGecko new service
-func NewCoinGecko() *Service {
+func NewCoinGecko(config *Config) *Service {
+	if config == nil {
+		config = &Config{}
+	}
+	maxPages := config.MaxPages
+	if maxPages <= 0 {
+		maxPages = DefaultMaxPages
+	}
+	// NOTE: every page is a request so keep it within the API rate limit
+	if maxPages > MaxPagesLimit {
+		maxPages = MaxPagesLimit
+	}
+	batchConcurrency := config.BatchConcurrency
+	if batchConcurrency <= 0 {
+		batchConcurrency = DefaultBatchConcurrency
+	}
+	if batchConcurrency > MaxBatchConcurrency {
+		batchConcurrency = MaxBatchConcurrency
+	}
+	idOverrides := make(map[string]string)
+	for name, id := range config.IDOverrides {
+		idOverrides[strings.ToLower(strings.TrimSpace(name))] = strings.TrimSpace(id)
+	}
 	client := gecko.NewClient(nil)
 	svc := &Service{
 		client:            client,
 		maxResultsPerPage: 250, // max is 250
-		maxPages:          10,
+		maxPages:          maxPages,
+		chartAutoInterval: config.ChartAutoInterval,
+		idOverrides:       idOverrides,
+		batchConcurrency:  batchConcurrency,
+		limiter:           time.Tick(batchInterval),
 		cacheMap:          sync.Map{},
 	}
 	svc.cacheCoinsIDList()
 	return svc
 }
 
+// SetSparkline sets whether the coin market data includes the 7 day sparkline, which makes the responses larger
+func (s *Service) SetSparkline(enabled bool) {
+	var v int32
+	if enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&s.sparkline, v)
+}
+
 // Ping ping API
 func (s *Service) Ping() error {
 	if _, err := s.client.Ping(); err != nil {
@@ -87,16 +159,121 @@ func (s *Service) GetCoinData(name string, convert string) (apitypes.Coin, error
 	return ret, nil
 }
 
-// GetCoinDataBatch gets all data of specified coins.
+// GetCoinDataBatch gets all data of specified coins. The coins are fetched in batches of the max results per page,
+// with up to the batch concurrency requests at a time, and returned in the order of the names
 func (s *Service) GetCoinDataBatch(names []string, convert string) ([]apitypes.Coin, error) {
-	return s.getPaginatedCoinData(convert, 0, names)
+	var ids []string
+	seen := make(map[string]bool)
+	for _, name := range names {
+		id := s.coinNameToID(name)
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var batches [][]string
+	for start := 0; start < len(ids); start += s.maxResultsPerPage {
+		end := start + s.maxResultsPerPage
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batches = append(batches, ids[start:end])
+	}
+
+	// NOTE: each batch writes to its own index so the results don't depend on which request finishes first
+	results := make([][]apitypes.Coin, len(batches))
+	errs := make([]error, len(batches))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < s.batchConcurrency && w < len(batches); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], errs[i] = s.getCoinsMarketData(convert, 0, batches[i])
+			}
+		}()
+	}
+	for i := range batches {
+		if i > 0 {
+			<-s.limiter
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	byID := make(map[string]apitypes.Coin)
+	for i, coins := range results {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		for _, coin := range coins {
+			byID[coin.ID] = coin
+		}
+	}
+	var ret []apitypes.Coin
+	for _, id := range ids {
+		if coin, ok := byID[id]; ok {
+			ret = append(ret, coin)
+		}
+	}
+
+	return ret, nil
+}
+
+// GetRecentlyAddedCoinData gets data of the most recently listed coins.
+func (s *Service) GetRecentlyAddedCoinData(convert string) ([]apitypes.Coin, error) {
+	list, err := s.client.CoinsListNew()
+	if err != nil {
+		var statusErr *gecko.StatusError
+		if errors.As(err, &statusErr) && (statusErr.StatusCode == http.StatusUnauthorized || statusErr.StatusCode == http.StatusForbidden) {
+			return nil, ErrPaidPlanRequired
+		}
+		return nil, err
+	}
+	if list == nil || len(*list) == 0 {
+		return nil, nil
+	}
+
+	var ids []string
+	activatedAt := make(map[string]int64)
+	for _, item := range *list {
+		if len(ids) >= s.maxResultsPerPage {
+			break
+		}
+		id := util.FormatID(item.ID)
+		ids = append(ids, id)
+		activatedAt[id] = item.ActivatedAt
+	}
+
+	coins, err := s.getCoinsMarketData(convert, 0, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range coins {
+		if ts, ok := activatedAt[coins[i].ID]; ok && ts > 0 {
+			coins[i].DateAdded = strconv.FormatInt(ts, 10)
+		}
+	}
+
+	return coins, nil
 }
 
 // GetCoinGraphData gets coin graph data
 func (s *Service) GetCoinGraphData(convert, symbol, name string, start, end int64) (apitypes.CoinGraph, error) {
 	ret := apitypes.CoinGraph{}
 	days := strconv.Itoa(util.CalcDays(start, end))
-	chart, err := s.client.CoinsIDMarketChart(s.coinNameToID(name), convert, days)
+	var interval string
+	if s.chartAutoInterval {
+		interval = getChartInterval(start, end)
+	}
+	chart, err := s.client.CoinsIDMarketChartInterval(s.coinNameToID(name), convert, days, interval)
 	if err != nil {
 		return ret, err
 	}
@@ -118,6 +295,24 @@ func (s *Service) GetCoinGraphData(convert, symbol, name string, start, end int6
 		}
 	}
 
+	if chart.TotalVolumes != nil {
+		for _, item := range *chart.TotalVolumes {
+			volumeCoin = append(volumeCoin, []float64{
+				float64(item[0]),
+				float64(item[1]),
+			})
+		}
+	}
+
+	if chart.MarketCaps != nil {
+		for _, item := range *chart.MarketCaps {
+			marketCap = append(marketCap, []float64{
+				float64(item[0]),
+				float64(item[1]),
+			})
+		}
+	}
+
 	ret.MarketCapByAvailableSupply = marketCap
 	ret.PriceBTC = priceBTC
 	ret.Price = priceCoin
@@ -126,6 +321,26 @@ func (s *Service) GetCoinGraphData(convert, symbol, name string, start, end int6
 	return ret, nil
 }
 
+// GetCoinOHLCData gets the coin candles between start and end
+func (s *Service) GetCoinOHLCData(convert, symbol, name string, start, end int64) (apitypes.CoinOHLC, error) {
+	ret := apitypes.CoinOHLC{}
+	days := getOHLCDays(util.CalcDays(start, end))
+	ohlc, err := s.client.CoinsIDOHLC(s.coinNameToID(name), convert, days)
+	if err != nil {
+		return ret, err
+	}
+
+	// NOTE: the days are rounded up to a value the API accepts so the candles before the start are dropped
+	for _, item := range *ohlc {
+		if int64(item[0]/1e3) < start {
+			continue
+		}
+		ret.Candles = append(ret.Candles, []float64{item[0], item[1], item[2], item[3], item[4]})
+	}
+
+	return ret, nil
+}
+
 // GetGlobalMarketGraphData gets global market graph data
 func (s *Service) GetGlobalMarketGraphData(convert string, start int64, end int64) (apitypes.MarketGraph, error) {
 	days := strconv.Itoa(util.CalcDays(start, end))
@@ -263,6 +478,11 @@ func (s *Service) SupportedCurrencies() []string {
 	}
 }
 
+// RefreshCoinIDs fetches the list of all coin IDs again so that newly listed coins can be looked up by name
+func (s *Service) RefreshCoinIDs() error {
+	return s.cacheCoinsIDList()
+}
+
 // cacheCoinsIDList fetches list of all coin IDS by name and symbols and caches it in a map for fast lookups
 func (s *Service) cacheCoinsIDList() error {
 	list, err := s.client.CoinsList()
@@ -272,7 +492,10 @@ func (s *Service) cacheCoinsIDList() error {
 	if list == nil {
 		return nil
 	}
-	firstWords := [][]string{}
+	// NOTE: the IDs are collected first so a rebuild replaces the cached IDs without a window of missing entries
+	ids := make(map[string]string)
+	firstWords := make(map[string]string)
+	ranks := s.coinRanks()
 	for _, item := range *list {
 		keys := []string{
 			strings.ToLower(item.Name),
@@ -283,29 +506,67 @@ func (s *Service) cacheCoinsIDList() error {
 		if len(parts) > 1 {
 			if parts[1] == "coin" {
 				keys = append(keys, parts[0])
-			} else {
-				firstWords = append(firstWords, []string{parts[0], item.ID})
+			} else if id, exists := firstWords[parts[0]]; !exists || rankedHigher(ranks, item.ID, id) {
+				firstWords[parts[0]] = item.ID
 			}
 		}
 		for _, key := range keys {
-			_, exists := s.cacheMap.Load(key)
-			if !exists {
-				s.cacheMap.Store(key, item.ID)
+			if id, exists := ids[key]; !exists || rankedHigher(ranks, item.ID, id) {
+				ids[key] = item.ID
 			}
 		}
 	}
-	for _, parts := range firstWords {
-		_, exists := s.cacheMap.Load(parts[0])
+	for word, id := range firstWords {
+		_, exists := ids[word]
 		if !exists {
-			s.cacheMap.Store(parts[0], parts[1])
+			ids[word] = id
 		}
 	}
+	for key, id := range ids {
+		s.cacheMap.Store(key, id)
+	}
+	s.cacheMap.Range(func(key, value interface{}) bool {
+		if _, ok := ids[key.(string)]; !ok {
+			s.cacheMap.Delete(key)
+		}
+		return true
+	})
 	return nil
 }
 
-// coinNameToID attempts to get coin ID based on coin name or coin symbol
+// coinRanks returns the market cap rank of the top coins by ID. The list of all coins has no ranks so the first
+// page of the markets is fetched to tell apart the coins sharing a name or symbol
+func (s *Service) coinRanks() map[string]int {
+	ranks := make(map[string]int)
+	order := geckoTypes.OrderTypeObject.MarketCapDesc
+	list, err := s.client.CoinsMarket("usd", nil, order, s.maxResultsPerPage, 1, false, nil)
+	if err != nil || list == nil {
+		return ranks
+	}
+	for i, item := range *list {
+		ranks[item.ID] = i + 1
+	}
+	return ranks
+}
+
+// rankedHigher returns true if the coin ID has a better rank than the other coin ID. Coins outside the ranks
+// rank below every ranked coin and keep the order of the list among themselves
+func rankedHigher(ranks map[string]int, id string, otherID string) bool {
+	rank, ok := ranks[id]
+	if !ok {
+		return false
+	}
+	otherRank, otherOk := ranks[otherID]
+	return !otherOk || rank < otherRank
+}
+
+// coinNameToID attempts to get coin ID based on coin name or coin symbol. The ID overrides are checked first
 func (s *Service) coinNameToID(name string) string {
-	id, ok := s.cacheMap.Load(strings.ToLower(strings.TrimSpace(name)))
+	key := strings.ToLower(strings.TrimSpace(name))
+	if id, ok := s.idOverrides[key]; ok {
+		return id
+	}
+	id, ok := s.cacheMap.Load(key)
 	if ok {
 		return id.(string)
 	}
@@ -314,9 +575,18 @@ func (s *Service) coinNameToID(name string) string {
 
 // getPaginatedCoinData fetches coin data from page offset
 func (s *Service) getPaginatedCoinData(convert string, offset int, names []string) ([]apitypes.Coin, error) {
+	ids := make([]string, len(names))
+	for i, name := range names {
+		ids[i] = s.coinNameToID(name)
+	}
+	return s.getCoinsMarketData(convert, offset, ids)
+}
+
+// getCoinsMarketData fetches market data of coin IDs from page offset
+func (s *Service) getCoinsMarketData(convert string, offset int, ids []string) ([]apitypes.Coin, error) {
 	var ret []apitypes.Coin
 	page := offset + 1 // page starts at 1
-	sparkline := false
+	sparkline := atomic.LoadInt32(&s.sparkline) == 1
 	pcp := geckoTypes.PriceChangePercentageObject
 	priceChangePercentage := []string{
 		pcp.PCP1h,
@@ -330,10 +600,6 @@ func (s *Service) getPaginatedCoinData(convert string, offset int, names []strin
 		convertTo = "usd"
 	}
 
-	ids := make([]string, len(names))
-	for i, name := range names {
-		ids[i] = s.coinNameToID(name)
-	}
 	list, err := s.client.CoinsMarket(convertTo, ids, order, s.maxResultsPerPage, page, sparkline, priceChangePercentage)
 	if err != nil {
 		return nil, err
@@ -366,12 +632,29 @@ func (s *Service) getPaginatedCoinData(convert string, offset int, names []strin
 				percentChange30D = *item.PriceChangePercentage30dInCurrency
 			}
 
+			// NOTE: the fully diluted valuation is null for coins without a max supply
+			var fdv float64
+			if item.FullyDilutedValuation != nil {
+				fdv = *item.FullyDilutedValuation
+			}
+
 			availableSupply := item.CirculatingSupply
 			totalSupply := item.TotalSupply
 			if totalSupply == 0 {
 				totalSupply = availableSupply
 			}
 
+			// NOTE: the max supply is null for coins without a supply cap
+			var maxSupply float64
+			if item.MaxSupply != nil {
+				maxSupply = *item.MaxSupply
+			}
+
+			var sparkline7D []float64
+			if item.SparklineIn7d != nil {
+				sparkline7D = item.SparklineIn7d.Price
+			}
+
 			ret = append(ret, apitypes.Coin{
 				ID:               util.FormatID(item.ID),
 				Name:             util.FormatName(item.Name),
@@ -379,7 +662,13 @@ func (s *Service) getPaginatedCoinData(convert string, offset int, names []strin
 				Rank:             util.FormatRank(item.MarketCapRank),
 				AvailableSupply:  util.FormatSupply(availableSupply),
 				TotalSupply:      util.FormatSupply(totalSupply),
+				MaxSupply:        util.FormatSupply(maxSupply),
 				MarketCap:        util.FormatMarketCap(item.MarketCap),
+				FDV:              util.FormatMarketCap(fdv),
+				High24H:          util.FormatPrice(item.High24, convert),
+				Low24H:           util.FormatPrice(item.Low24, convert),
+				ATH:              util.FormatPrice(item.ATH, convert),
+				PercentFromATH:   util.FormatPercentChange(item.ATHChangePercentage),
 				Price:            util.FormatPrice(price, convert),
 				PercentChange1H:  util.FormatPercentChange(percentChange1H),
 				PercentChange24H: util.FormatPercentChange(percentChange24H),
@@ -387,9 +676,32 @@ func (s *Service) getPaginatedCoinData(convert string, offset int, names []strin
 				PercentChange30D: util.FormatPercentChange(percentChange30D),
 				Volume24H:        util.FormatVolume(item.TotalVolume),
 				LastUpdated:      util.FormatLastUpdated(item.LastUpdated),
+				Sparkline7D:      sparkline7D,
 			})
 		}
 	}
 
 	return ret, nil
 }
+
+// getChartInterval returns the interval to use for given time range.
+// Ranges up to 90 days use the 5 minute and hourly data the API returns by default, since requesting the hourly
+// interval is limited to the Enterprise plan
+func getChartInterval(start, end int64) string {
+	interval := ""
+	delta := end - start
+	if delta > 7776000 {
+		interval = "daily"
+	}
+	return interval
+}
+
+// getOHLCDays returns the smallest number of days accepted by the OHLC endpoint that covers the days
+func getOHLCDays(days int) string {
+	for _, d := range []int{1, 7, 14, 30, 90, 180, 365} {
+		if days <= d {
+			return strconv.Itoa(d)
+		}
+	}
+	return "max"
+}
@@ -0,0 +1,107 @@
+package coingecko
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	gecko "github.com/miguelmota/cointop/pkg/api/vendors/coingecko/v3"
+)
+
+// TestGetCoinDataBatchOrder checks that the batches are of the max results per page and that the coins are
+// returned in the order of the names when the requests finish out of order
+func TestGetCoinDataBatchOrder(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/coins/markets" {
+			t.Errorf("path == %q, want /coins/markets", r.URL.Path)
+		}
+		if perPage := r.URL.Query().Get("per_page"); perPage != "3" {
+			t.Errorf("per page == %q, want 3", perPage)
+		}
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		mu.Lock()
+		batches = append(batches, ids)
+		mu.Unlock()
+
+		// NOTE: the first batches answer last and every batch answers in reverse order
+		if ids[0] == "coin-0" {
+			time.Sleep(60 * time.Millisecond)
+		} else if ids[0] == "coin-3" {
+			time.Sleep(30 * time.Millisecond)
+		}
+		var items []map[string]interface{}
+		for i := len(ids) - 1; i >= 0; i-- {
+			items = append(items, map[string]interface{}{
+				"id":            ids[i],
+				"name":          ids[i],
+				"symbol":        ids[i],
+				"current_price": 1,
+			})
+		}
+		json.NewEncoder(w).Encode(items)
+	}))
+	defer server.Close()
+
+	client := gecko.NewClient(nil)
+	client.SetBaseURL(server.URL)
+	svc := &Service{
+		client:            client,
+		maxResultsPerPage: 3,
+		batchConcurrency:  MaxBatchConcurrency,
+		limiter:           time.Tick(time.Millisecond),
+	}
+
+	var names []string
+	for i := 0; i < 7; i++ {
+		names = append(names, fmt.Sprintf("coin-%d", i))
+	}
+	coins, err := svc.GetCoinDataBatch(names, "usd")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(batches) != 3 {
+		t.Errorf("batches == %v, want 3 batches", batches)
+	}
+	for _, batch := range batches {
+		if len(batch) > svc.maxResultsPerPage {
+			t.Errorf("batch == %v, want at most %d coins", batch, svc.maxResultsPerPage)
+		}
+	}
+	if len(coins) != len(names) {
+		t.Fatalf("coins == %d, want %d", len(coins), len(names))
+	}
+	for i, coin := range coins {
+		if coin.ID != names[i] {
+			t.Errorf("coin %d == %s, want %s", i, coin.ID, names[i])
+		}
+	}
+}
+
+// TestGetRecentlyAddedCoinDataPaidPlan checks that an unauthorized response of the new coins list is reported as
+// requiring a paid plan
+func TestGetRecentlyAddedCoinDataPaidPlan(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/coins/list/new" {
+				t.Errorf("path == %q, want /coins/list/new", r.URL.Path)
+			}
+			http.Error(w, `{"error": "plan required"}`, status)
+		}))
+
+		client := gecko.NewClient(nil)
+		client.SetBaseURL(server.URL)
+		svc := &Service{client: client, maxResultsPerPage: 250}
+		if _, err := svc.GetRecentlyAddedCoinData("usd"); err != ErrPaidPlanRequired {
+			t.Errorf("status %d err == %v, want ErrPaidPlanRequired", status, err)
+		}
+		server.Close()
+	}
+}
@@ -13,7 +13,7 @@ import (
 
 	apitypes "github.com/miguelmota/cointop/pkg/api/types"
 	util "github.com/miguelmota/cointop/pkg/api/util"
-	cmc "github.com/miguelmota/go-coinmarketcap/pro/v1"
+	cmc "github.com/miguelmota/cointop/pkg/api/vendors/coinmarketcap/pro/v1"
 	cmcv2 "github.com/miguelmota/go-coinmarketcap/v2"
 )
 
@@ -26,18 +26,32 @@ var ErrPingFailed = errors.New("failed to ping")
 // ErrFetchGraphData is the error for when fetching graph data fails
 var ErrFetchGraphData = errors.New("graph data fetch error")
 
+// ErrOHLCNotSupported is the error for when the API plan doesn't provide OHLC data
+var ErrOHLCNotSupported = errors.New("OHLC data is not supported")
+
 // Service service
 type Service struct {
 	client *cmc.Client
 }
 
+// Config is the CoinMarketCap service config
+type Config struct {
+	APIKey  string
+	BaseURL string
+}
+
 // NewCMC new service
-func NewCMC(apiKey string) *Service {
+func NewCMC(config *Config) *Service {
+	if config == nil {
+		config = &Config{}
+	}
+	apiKey := config.APIKey
 	if apiKey == "" {
 		apiKey = os.Getenv("CMC_PRO_API_KEY")
 	}
 	client := cmc.NewClient(&cmc.Config{
 		ProAPIKey: apiKey,
+		BaseURL:   config.BaseURL,
 	})
 	return &Service{
 		client: client,
@@ -59,14 +73,20 @@ func (s *Service) Ping() error {
 }
 
 func (s *Service) getPaginatedCoinData(convert string, offset int) ([]apitypes.Coin, error) {
-	var ret []apitypes.Coin
 	max := 100
 
-	listings, err := s.client.Cryptocurrency.LatestListings(&cmc.ListingOptions{
+	return s.getListingsCoinData(&cmc.ListingOptions{
 		Limit:   max,
 		Convert: convert,
 		Start:   max * offset,
 	})
+}
+
+// getListingsCoinData fetches coin data of the latest listings
+func (s *Service) getListingsCoinData(options *cmc.ListingOptions) ([]apitypes.Coin, error) {
+	var ret []apitypes.Coin
+	convert := options.Convert
+	listings, err := s.client.Cryptocurrency.LatestListings(options)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +103,7 @@ func (s *Service) getPaginatedCoinData(convert string, offset int) ([]apitypes.C
 			Rank:             util.FormatRank(v.CMCRank),
 			AvailableSupply:  util.FormatSupply(v.CirculatingSupply),
 			TotalSupply:      util.FormatSupply(v.TotalSupply),
+			MaxSupply:        util.FormatSupply(v.MaxSupply),
 			MarketCap:        util.FormatMarketCap(quote.MarketCap),
 			Price:            util.FormatPrice(v.Quote[convert].Price, convert),
 			PercentChange1H:  util.FormatPercentChange(quote.PercentChange1H),
@@ -90,6 +111,7 @@ func (s *Service) getPaginatedCoinData(convert string, offset int) ([]apitypes.C
 			PercentChange7D:  util.FormatPercentChange(quote.PercentChange7D),
 			Volume24H:        util.FormatVolume(v.Quote[convert].Volume24H),
 			LastUpdated:      util.FormatLastUpdated(v.LastUpdated),
+			DateAdded:        util.FormatDateAdded(v.DateAdded),
 		})
 	}
 	return ret, nil
@@ -153,6 +175,16 @@ func (s *Service) GetCoinDataBatch(names []string, convert string) ([]apitypes.C
 	return ret, nil
 }
 
+// GetRecentlyAddedCoinData gets data of the most recently listed coins.
+func (s *Service) GetRecentlyAddedCoinData(convert string) ([]apitypes.Coin, error) {
+	return s.getListingsCoinData(&cmc.ListingOptions{
+		Limit:   100,
+		Convert: convert,
+		Sort:    "date_added",
+		SortDir: "desc",
+	})
+}
+
 // GetCoinGraphData gets coin graph data
 func (s *Service) GetCoinGraphData(convert, symbol string, name string, start int64, end int64) (apitypes.CoinGraph, error) {
 	ret := apitypes.CoinGraph{}
@@ -203,6 +235,7 @@ func (s *Service) GetCoinGraphData(convert, symbol string, name string, start in
 		return ret, ErrFetchGraphData
 	}
 	var prices [][]float64
+	var volumes [][]float64
 	for datetime, item := range ifcs {
 		ifc, ok := item.(map[string]interface{})
 		if !ok {
@@ -225,15 +258,30 @@ func (s *Service) GetCoinGraphData(convert, symbol string, name string, start in
 				return ret, err
 			}
 			prices = append(prices, []float64{float64(t.Unix()), val})
+			// NOTE: the values are price, 24h volume and market cap
+			if len(arrIfc) > 1 {
+				if volume, ok := arrIfc[1].(float64); ok {
+					volumes = append(volumes, []float64{float64(t.Unix()), volume})
+				}
+			}
 		}
 	}
 	sort.Slice(prices[:], func(i, j int) bool {
 		return prices[i][0] < prices[j][0]
 	})
+	sort.Slice(volumes[:], func(i, j int) bool {
+		return volumes[i][0] < volumes[j][0]
+	})
 	ret.Price = prices
+	ret.Volume = volumes
 	return ret, nil
 }
 
+// GetCoinOHLCData gets the coin candles. The OHLC endpoints aren't available on the free API plan
+func (s *Service) GetCoinOHLCData(convert, symbol string, name string, start int64, end int64) (apitypes.CoinOHLC, error) {
+	return apitypes.CoinOHLC{}, ErrOHLCNotSupported
+}
+
 // GetGlobalMarketGraphData gets global market graph data
 func (s *Service) GetGlobalMarketGraphData(convert string, start int64, end int64) (apitypes.MarketGraph, error) {
 	ret := apitypes.MarketGraph{}
@@ -331,6 +379,11 @@ func (s *Service) Price(name string, convert string) (float64, error) {
 	return util.FormatPrice(price, convert), nil
 }
 
+// RefreshCoinIDs does nothing since coins are looked up without a coin ID list
+func (s *Service) RefreshCoinIDs() error {
+	return nil
+}
+
 // CoinLink returns the URL link for the coin
 func (s *Service) CoinLink(name string) string {
 	slug := util.NameToSlug(name)
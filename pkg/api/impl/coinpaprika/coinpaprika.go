@@ -0,0 +1,475 @@
+package coinpaprika
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	apitypes "github.com/miguelmota/cointop/pkg/api/types"
+	util "github.com/miguelmota/cointop/pkg/api/util"
+	paprika "github.com/miguelmota/cointop/pkg/api/vendors/coinpaprika/v1"
+)
+
+// ErrNotFound is the error when the target is not found
+var ErrNotFound = errors.New("not found")
+
+// ErrNoGlobalMarketGraph is the error when the global market graph is requested, since the API has no global
+// market history
+var ErrNoGlobalMarketGraph = errors.New("the global market chart isn't available from CoinPaprika")
+
+// DefaultMaxPages is the default number of coin pages returned
+const DefaultMaxPages = 10
+
+// tickersTTL is how long the tickers of all the coins are reused, so the batch and recently added requests made
+// during a refresh don't download them again
+const tickersTTL = 1 * time.Minute
+
+// maxSingleTickers is the max number of coins of a batch that are requested one by one instead of with the
+// tickers of all the coins
+const maxSingleTickers = 10
+
+// Service service
+type Service struct {
+	client            *paprika.Client
+	maxResultsPerPage int
+	maxPages          int
+	cacheMap          sync.Map
+	tickersLock       sync.Mutex
+	tickers           []paprika.Ticker
+	tickersQuote      string
+	tickersFetchedAt  time.Time
+}
+
+// NewCoinPaprika new service
+func NewCoinPaprika() *Service {
+	client := paprika.NewClient(nil)
+	svc := &Service{
+		client:            client,
+		maxResultsPerPage: 250,
+		maxPages:          DefaultMaxPages,
+		cacheMap:          sync.Map{},
+	}
+	svc.cacheCoinsIDList()
+	return svc
+}
+
+// Ping ping API
+func (s *Service) Ping() error {
+	if _, err := s.client.Global(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetAllCoinData gets all coin data. The tickers endpoint returns every coin in a single request
+// so the coins are sent in pages like the other APIs
+func (s *Service) GetAllCoinData(convert string, ch chan []apitypes.Coin) error {
+	go func() {
+		defer close(ch)
+
+		tickers, err := s.getTickers(convert, true)
+		if err != nil {
+			return
+		}
+
+		coins := s.tickersToCoins(tickers, convert)
+		for i := 0; i < s.maxPages; i++ {
+			start := i * s.maxResultsPerPage
+			if start >= len(coins) {
+				return
+			}
+
+			end := start + s.maxResultsPerPage
+			if end > len(coins) {
+				end = len(coins)
+			}
+
+			ch <- coins[start:end]
+		}
+	}()
+	return nil
+}
+
+// GetCoinData gets all data of a coin.
+func (s *Service) GetCoinData(name string, convert string) (apitypes.Coin, error) {
+	ret := apitypes.Coin{}
+	ticker, err := s.client.Ticker(s.coinNameToID(name), []string{convertTo(convert)})
+	if err != nil {
+		return ret, err
+	}
+
+	coins := s.tickersToCoins([]paprika.Ticker{*ticker}, convert)
+	if len(coins) > 0 {
+		ret = coins[0]
+	}
+
+	return ret, nil
+}
+
+// GetCoinDataBatch gets all data of specified coins. Small batches request the tickers of the coins one by one
+// and larger batches use the tickers of all the coins
+func (s *Service) GetCoinDataBatch(names []string, convert string) ([]apitypes.Coin, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	if len(names) <= maxSingleTickers {
+		var list []paprika.Ticker
+		for _, name := range names {
+			ticker, err := s.client.Ticker(s.coinNameToID(name), []string{convertTo(convert)})
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, *ticker)
+		}
+		return s.tickersToCoins(list, convert), nil
+	}
+
+	tickers, err := s.getTickers(convert, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]bool)
+	for _, name := range names {
+		ids[s.coinNameToID(name)] = true
+	}
+
+	var list []paprika.Ticker
+	for _, ticker := range tickers {
+		if ids[ticker.ID] {
+			list = append(list, ticker)
+		}
+	}
+
+	return s.tickersToCoins(list, convert), nil
+}
+
+// GetRecentlyAddedCoinData gets data of the most recently listed coins.
+func (s *Service) GetRecentlyAddedCoinData(convert string) ([]apitypes.Coin, error) {
+	cached, err := s.getTickers(convert, false)
+	if err != nil {
+		return nil, err
+	}
+	// NOTE: the tickers are copied so sorting doesn't reorder the cached tickers
+	tickers := append([]paprika.Ticker(nil), cached...)
+
+	// NOTE: the timestamps are RFC3339 in UTC so they sort as strings
+	sort.SliceStable(tickers, func(i, j int) bool {
+		return tickers[i].FirstDataAt > tickers[j].FirstDataAt
+	})
+	if len(tickers) > s.maxResultsPerPage {
+		tickers = tickers[:s.maxResultsPerPage]
+	}
+
+	return s.tickersToCoins(tickers, convert), nil
+}
+
+// GetCoinGraphData gets coin graph data
+func (s *Service) GetCoinGraphData(convert, symbol, name string, start, end int64) (apitypes.CoinGraph, error) {
+	ret := apitypes.CoinGraph{}
+	list, err := s.client.TickersHistorical(s.coinNameToID(name), convertTo(convert), start, end, getChartInterval(start, end))
+	if err != nil {
+		return ret, err
+	}
+
+	var marketCap [][]float64
+	var priceCoin [][]float64
+	var volumeCoin [][]float64
+
+	for _, item := range list {
+		t, err := time.Parse(time.RFC3339, item.Timestamp)
+		if err != nil {
+			continue
+		}
+		timestamp := float64(t.Unix() * 1000)
+
+		priceCoin = append(priceCoin, []float64{
+			timestamp,
+			item.Price,
+		})
+		volumeCoin = append(volumeCoin, []float64{
+			timestamp,
+			item.Volume24h,
+		})
+		marketCap = append(marketCap, []float64{
+			timestamp,
+			item.MarketCap,
+		})
+	}
+
+	ret.MarketCapByAvailableSupply = marketCap
+	ret.Price = priceCoin
+	ret.Volume = volumeCoin
+
+	return ret, nil
+}
+
+// GetCoinOHLCData gets the daily coin candles between start and end
+func (s *Service) GetCoinOHLCData(convert, symbol, name string, start, end int64) (apitypes.CoinOHLC, error) {
+	ret := apitypes.CoinOHLC{}
+	list, err := s.client.OHLCVHistorical(s.coinNameToID(name), convertTo(convert), start, end)
+	if err != nil {
+		return ret, err
+	}
+
+	for _, item := range list {
+		t, err := time.Parse(time.RFC3339, item.TimeOpen)
+		if err != nil {
+			continue
+		}
+		ret.Candles = append(ret.Candles, []float64{
+			float64(t.Unix() * 1000),
+			item.Open,
+			item.High,
+			item.Low,
+			item.Close,
+		})
+	}
+
+	return ret, nil
+}
+
+// GetGlobalMarketGraphData gets global market graph data. The API doesn't provide global market history
+func (s *Service) GetGlobalMarketGraphData(convert string, start int64, end int64) (apitypes.MarketGraph, error) {
+	return apitypes.MarketGraph{}, ErrNoGlobalMarketGraph
+}
+
+// GetGlobalMarketData gets global market data
+func (s *Service) GetGlobalMarketData(convert string) (apitypes.GlobalMarketData, error) {
+	ret := apitypes.GlobalMarketData{}
+	market, err := s.client.Global()
+	if err != nil {
+		return ret, err
+	}
+
+	// NOTE: the global values are only in USD so they're converted with the exchange rate implied by the bitcoin quotes
+	rate := 1.0
+	convert = convertTo(convert)
+	if convert != "USD" {
+		ticker, err := s.client.Ticker("btc-bitcoin", []string{"USD", convert})
+		if err != nil {
+			return ret, err
+		}
+		usd, ok := ticker.Quotes["USD"]
+		quote, found := ticker.Quotes[convert]
+		if !ok || !found || usd.Price == 0 {
+			return ret, ErrNotFound
+		}
+		rate = quote.Price / usd.Price
+	}
+
+	ret = apitypes.GlobalMarketData{
+		TotalMarketCapUSD:            market.MarketCapUSD * rate,
+		Total24HVolumeUSD:            market.Volume24hUSD * rate,
+		BitcoinPercentageOfMarketCap: market.BitcoinDominancePercentage,
+		ActiveCurrencies:             market.CryptocurrenciesNumber,
+		ActiveAssets:                 0,
+		ActiveMarkets:                0,
+	}
+
+	return ret, nil
+}
+
+// Price returns the current price of the coin
+func (s *Service) Price(name string, convert string) (float64, error) {
+	convert = convertTo(convert)
+	ticker, err := s.client.Ticker(s.coinNameToID(name), []string{convert})
+	if err != nil {
+		return 0, err
+	}
+
+	quote, ok := ticker.Quotes[convert]
+	if !ok {
+		return 0, ErrNotFound
+	}
+
+	return util.FormatPrice(quote.Price, convert), nil
+}
+
+// CoinLink returns the URL link for the coin
+func (s *Service) CoinLink(name string) string {
+	ID := s.coinNameToID(name)
+	return fmt.Sprintf("https://coinpaprika.com/coin/%s/", ID)
+}
+
+// SupportedCurrencies returns a list of supported currencies
+func (s *Service) SupportedCurrencies() []string {
+
+	// keep these in alphabetical order
+	return []string{
+		"ARS",
+		"AUD",
+		"BRL",
+		"BTC",
+		"CAD",
+		"CHF",
+		"CLP",
+		"CNY",
+		"CZK",
+		"DKK",
+		"ETH",
+		"EUR",
+		"GBP",
+		"HKD",
+		"HUF",
+		"IDR",
+		"ILS",
+		"INR",
+		"JPY",
+		"KRW",
+		"MXN",
+		"MYR",
+		"NGN",
+		"NOK",
+		"NZD",
+		"PHP",
+		"PKR",
+		"PLN",
+		"RUB",
+		"SEK",
+		"SGD",
+		"THB",
+		"TRY",
+		"TWD",
+		"UAH",
+		"USD",
+		"VND",
+		"ZAR",
+	}
+}
+
+// RefreshCoinIDs fetches the list of all coin IDs again so that newly listed coins can be looked up by name
+func (s *Service) RefreshCoinIDs() error {
+	return s.cacheCoinsIDList()
+}
+
+// cacheCoinsIDList fetches list of all coin IDS by name and symbols and caches it in a map for fast lookups
+func (s *Service) cacheCoinsIDList() error {
+	list, err := s.client.Coins()
+	if err != nil {
+		return err
+	}
+	// NOTE: the list is ordered by rank so the highest ranked coin wins when names or symbols collide
+	ids := make(map[string]string)
+	for _, item := range list {
+		keys := []string{
+			strings.ToLower(item.ID),
+			strings.ToLower(item.Name),
+			strings.ToLower(item.Symbol),
+			util.NameToSlug(item.Name),
+		}
+		for _, key := range keys {
+			_, exists := ids[key]
+			if !exists {
+				ids[key] = item.ID
+			}
+		}
+	}
+	for key, id := range ids {
+		s.cacheMap.Store(key, id)
+	}
+	s.cacheMap.Range(func(key, value interface{}) bool {
+		if _, ok := ids[key.(string)]; !ok {
+			s.cacheMap.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
+// getTickers returns the tickers of all the coins quoted in the currency. The tickers fetched within the TTL are
+// reused unless refresh is set
+func (s *Service) getTickers(convert string, refresh bool) ([]paprika.Ticker, error) {
+	s.tickersLock.Lock()
+	defer s.tickersLock.Unlock()
+	quote := convertTo(convert)
+	if !refresh && s.tickersQuote == quote && time.Since(s.tickersFetchedAt) < tickersTTL {
+		return s.tickers, nil
+	}
+
+	tickers, err := s.client.Tickers([]string{quote})
+	if err != nil {
+		return nil, err
+	}
+	s.tickers = tickers
+	s.tickersQuote = quote
+	s.tickersFetchedAt = time.Now()
+	return tickers, nil
+}
+
+// coinNameToID attempts to get coin ID based on coin name or coin symbol
+func (s *Service) coinNameToID(name string) string {
+	id, ok := s.cacheMap.Load(strings.ToLower(strings.TrimSpace(name)))
+	if ok {
+		return id.(string)
+	}
+	return util.NameToSlug(name)
+}
+
+// tickersToCoins converts the tickers to coins using the quotes of the currency
+func (s *Service) tickersToCoins(tickers []paprika.Ticker, convert string) []apitypes.Coin {
+	var ret []apitypes.Coin
+	for _, item := range tickers {
+		quote := item.Quotes[convertTo(convert)]
+		availableSupply := item.CirculatingSupply
+		totalSupply := item.TotalSupply
+		if totalSupply == 0 {
+			totalSupply = availableSupply
+		}
+
+		ret = append(ret, apitypes.Coin{
+			ID:               util.FormatID(item.ID),
+			Name:             util.FormatName(item.Name),
+			Symbol:           util.FormatSymbol(item.Symbol),
+			Rank:             util.FormatRank(item.Rank),
+			AvailableSupply:  util.FormatSupply(availableSupply),
+			TotalSupply:      util.FormatSupply(totalSupply),
+			MaxSupply:        util.FormatSupply(item.MaxSupply),
+			MarketCap:        util.FormatMarketCap(quote.MarketCap),
+			FDV:              util.FormatMarketCap(quote.FullyDilutedMarketCap),
+			ATH:              util.FormatPrice(quote.ATHPrice, convert),
+			PercentFromATH:   util.FormatPercentChange(quote.PercentFromPriceATH),
+			Price:            util.FormatPrice(quote.Price, convert),
+			PercentChange1H:  util.FormatPercentChange(quote.PercentChange1h),
+			PercentChange24H: util.FormatPercentChange(quote.PercentChange24h),
+			PercentChange7D:  util.FormatPercentChange(quote.PercentChange7d),
+			PercentChange30D: util.FormatPercentChange(quote.PercentChange30d),
+			Volume24H:        util.FormatVolume(quote.Volume24h),
+			LastUpdated:      util.FormatLastUpdated(item.LastUpdated),
+			DateAdded:        util.FormatDateAdded(item.FirstDataAt),
+		})
+	}
+
+	return ret
+}
+
+// convertTo returns the quote currency for the convert value
+func convertTo(convert string) string {
+	if convert == "" {
+		return "USD"
+	}
+	return strings.ToUpper(convert)
+}
+
+// getChartInterval returns the interval to use for given time range.
+// The intervals keep the number of data points under the API limit per request
+func getChartInterval(start, end int64) string {
+	delta := end - start
+	switch {
+	case delta <= 86400:
+		return "5m"
+	case delta <= 604800:
+		return "1h"
+	case delta <= 7776000:
+		return "6h"
+	case delta <= 31536000:
+		return "1d"
+	default:
+		return "7d"
+	}
+}
@@ -0,0 +1,137 @@
+package coinpaprika
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	apitypes "github.com/miguelmota/cointop/pkg/api/types"
+	paprika "github.com/miguelmota/cointop/pkg/api/vendors/coinpaprika/v1"
+)
+
+// tickersFixture is the response of the tickers endpoint, ordered by rank
+const tickersFixture = `[
+	{"id": "btc-bitcoin", "name": "Bitcoin", "symbol": "BTC", "rank": 1, "first_data_at": "2010-07-17T00:00:00Z", "quotes": {"USD": {"price": 50000}}},
+	{"id": "eth-ethereum", "name": "Ethereum", "symbol": "ETH", "rank": 2, "first_data_at": "2015-08-07T00:00:00Z", "quotes": {"USD": {"price": 3000}}},
+	{"id": "new-newcoin", "name": "Newcoin", "symbol": "NEW", "rank": 3, "first_data_at": "2021-05-01T00:00:00Z", "quotes": {"USD": {"price": 1}}}
+]`
+
+// newTestService returns a service of the fixtures server and the number of requests made to each path
+func newTestService() (*Service, map[string]int, func()) {
+	var mu sync.Mutex
+	requests := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.URL.Path]++
+		mu.Unlock()
+		switch {
+		case r.URL.Path == "/tickers":
+			w.Write([]byte(tickersFixture))
+		case strings.HasPrefix(r.URL.Path, "/tickers/"):
+			id := strings.TrimPrefix(r.URL.Path, "/tickers/")
+			fmt.Fprintf(w, `{"id": %q, "name": %q, "symbol": "X", "rank": 9, "quotes": {"USD": {"price": 2}}}`, id, id)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	client := paprika.NewClient(nil)
+	client.SetBaseURL(server.URL)
+	svc := &Service{
+		client:            client,
+		maxResultsPerPage: 2,
+		maxPages:          DefaultMaxPages,
+	}
+	return svc, requests, server.Close
+}
+
+// TestGetAllCoinData checks that the tickers are sent in pages of the max results
+func TestGetAllCoinData(t *testing.T) {
+	svc, _, done := newTestService()
+	defer done()
+
+	ch := make(chan []apitypes.Coin)
+	if err := svc.GetAllCoinData("USD", ch); err != nil {
+		t.Fatal(err)
+	}
+	var pages [][]apitypes.Coin
+	for coins := range ch {
+		pages = append(pages, coins)
+	}
+	if len(pages) != 2 || len(pages[0]) != 2 || len(pages[1]) != 1 {
+		t.Fatalf("pages == %v, want pages of 2 and 1 coins", pages)
+	}
+	if pages[0][0].Name != "Bitcoin" || pages[0][0].Price != 50000 {
+		t.Errorf("coin == %s %v, want Bitcoin 50000", pages[0][0].Name, pages[0][0].Price)
+	}
+}
+
+// TestGetCoinDataBatch checks that a small batch requests the tickers of the coins and a large batch reuses the
+// tickers of all the coins
+func TestGetCoinDataBatch(t *testing.T) {
+	svc, requests, done := newTestService()
+	defer done()
+
+	coins, err := svc.GetCoinDataBatch([]string{"btc-bitcoin", "eth-ethereum"}, "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(coins) != 2 || requests["/tickers/btc-bitcoin"] != 1 || requests["/tickers"] != 0 {
+		t.Errorf("coins == %d, requests == %v, want 2 coins from the single tickers", len(coins), requests)
+	}
+
+	names := []string{"btc-bitcoin", "eth-ethereum"}
+	for i := 0; i < maxSingleTickers; i++ {
+		names = append(names, fmt.Sprintf("coin-%d", i))
+	}
+	for i := 0; i < 2; i++ {
+		coins, err = svc.GetCoinDataBatch(names, "USD")
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(coins) != 2 {
+		t.Errorf("coins == %d, want the 2 listed coins", len(coins))
+	}
+	if requests["/tickers"] != 1 {
+		t.Errorf("tickers requests == %d, want 1", requests["/tickers"])
+	}
+}
+
+// TestGetRecentlyAddedCoinData checks that the coins are ordered by the first data time without reordering the
+// cached tickers
+func TestGetRecentlyAddedCoinData(t *testing.T) {
+	svc, requests, done := newTestService()
+	defer done()
+
+	coins, err := svc.GetRecentlyAddedCoinData("USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(coins) != 2 || coins[0].Name != "Newcoin" || coins[1].Name != "Ethereum" {
+		t.Errorf("coins == %v, want Newcoin and Ethereum", coins)
+	}
+	if svc.tickers[0].ID != "btc-bitcoin" {
+		t.Errorf("first cached ticker == %s, want btc-bitcoin", svc.tickers[0].ID)
+	}
+
+	ch := make(chan []apitypes.Coin)
+	svc.GetAllCoinData("USD", ch)
+	for range ch {
+	}
+	if requests["/tickers"] != 2 {
+		t.Errorf("tickers requests == %d, want a refresh to request the tickers again", requests["/tickers"])
+	}
+}
+
+// TestGetGlobalMarketGraphData checks that the missing global market history is an error
+func TestGetGlobalMarketGraphData(t *testing.T) {
+	svc, _, done := newTestService()
+	defer done()
+
+	if _, err := svc.GetGlobalMarketGraphData("USD", 0, 86400); err != ErrNoGlobalMarketGraph {
+		t.Errorf("err == %v, want ErrNoGlobalMarketGraph", err)
+	}
+}
@@ -0,0 +1,259 @@
+// Package demo is an API of fixed coin data that doesn't make any requests, for trying cointop out offline
+package demo
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"time"
+
+	apitypes "github.com/miguelmota/cointop/pkg/api/types"
+	util "github.com/miguelmota/cointop/pkg/api/util"
+)
+
+// ErrNotFound is the error when the target is not found
+var ErrNotFound = errors.New("not found")
+
+// graphPoints is the number of points of the graph data
+const graphPoints = 200
+
+// Service service
+type Service struct{}
+
+// NewDemo new service
+func NewDemo() *Service {
+	return &Service{}
+}
+
+// Ping ping API
+func (s *Service) Ping() error {
+	return nil
+}
+
+// GetAllCoinData gets all coin data. The fixtures are sent in a single page
+func (s *Service) GetAllCoinData(convert string, ch chan []apitypes.Coin) error {
+	go func() {
+		defer close(ch)
+		ch <- s.fixturesToCoins(fixtures, convert)
+	}()
+	return nil
+}
+
+// GetCoinData gets all data of a coin.
+func (s *Service) GetCoinData(name string, convert string) (apitypes.Coin, error) {
+	item, ok := findFixture(name)
+	if !ok {
+		return apitypes.Coin{}, ErrNotFound
+	}
+	return s.fixturesToCoins([]fixture{item}, convert)[0], nil
+}
+
+// GetCoinDataBatch gets all data of specified coins.
+func (s *Service) GetCoinDataBatch(names []string, convert string) ([]apitypes.Coin, error) {
+	var list []fixture
+	for _, name := range names {
+		if item, ok := findFixture(name); ok {
+			list = append(list, item)
+		}
+	}
+	return s.fixturesToCoins(list, convert), nil
+}
+
+// GetRecentlyAddedCoinData gets data of the most recently listed coins. The demo data has no new listings
+func (s *Service) GetRecentlyAddedCoinData(convert string) ([]apitypes.Coin, error) {
+	return nil, nil
+}
+
+// GetCoinGraphData gets coin graph data. The data is generated from the coin so it's the same on every run
+func (s *Service) GetCoinGraphData(convert, symbol, name string, start, end int64) (apitypes.CoinGraph, error) {
+	ret := apitypes.CoinGraph{}
+	item, ok := findFixture(name)
+	if !ok {
+		return ret, ErrNotFound
+	}
+
+	rate := rateOf(convert)
+	phase := phaseOf(item.ID)
+	step := float64(end-start) / graphPoints
+	for i := 0; i <= graphPoints; i++ {
+		timestamp := float64(start)*1e3 + float64(i)*step*1e3
+		m := multiplier(graphPoints-i, phase)
+		ret.Price = append(ret.Price, []float64{timestamp, util.FormatPrice(item.Price*rate*m, convert)})
+		ret.MarketCapByAvailableSupply = append(ret.MarketCapByAvailableSupply, []float64{timestamp, item.MarketCap * rate * m})
+		ret.Volume = append(ret.Volume, []float64{timestamp, item.Volume24H * rate * multiplier(graphPoints-i, phase*2)})
+	}
+
+	return ret, nil
+}
+
+// GetCoinOHLCData gets the daily coin candles between start and end
+func (s *Service) GetCoinOHLCData(convert, symbol, name string, start, end int64) (apitypes.CoinOHLC, error) {
+	ret := apitypes.CoinOHLC{}
+	item, ok := findFixture(name)
+	if !ok {
+		return ret, ErrNotFound
+	}
+
+	rate := rateOf(convert)
+	phase := phaseOf(item.ID)
+	days := util.CalcDays(start, end)
+	for i := 0; i < days; i++ {
+		open := item.Price * rate * multiplier(days-i, phase)
+		close := item.Price * rate * multiplier(days-i-1, phase)
+		ret.Candles = append(ret.Candles, []float64{
+			float64((start + int64(i)*86400) * 1e3),
+			util.FormatPrice(open, convert),
+			util.FormatPrice(math.Max(open, close)*1.012, convert),
+			util.FormatPrice(math.Min(open, close)*0.988, convert),
+			util.FormatPrice(close, convert),
+		})
+	}
+
+	return ret, nil
+}
+
+// GetGlobalMarketGraphData gets global market graph data
+func (s *Service) GetGlobalMarketGraphData(convert string, start int64, end int64) (apitypes.MarketGraph, error) {
+	ret := apitypes.MarketGraph{}
+	market, _ := s.GetGlobalMarketData(convert)
+	step := float64(end-start) / graphPoints
+	for i := 0; i <= graphPoints; i++ {
+		timestamp := float64(start)*1e3 + float64(i)*step*1e3
+		ret.MarketCapByAvailableSupply = append(ret.MarketCapByAvailableSupply, []float64{timestamp, market.TotalMarketCapUSD * multiplier(graphPoints-i, 0)})
+		ret.VolumeUSD = append(ret.VolumeUSD, []float64{timestamp, market.Total24HVolumeUSD * multiplier(graphPoints-i, 1)})
+	}
+	return ret, nil
+}
+
+// GetGlobalMarketData gets global market data. The totals are the sums of the demo coins
+func (s *Service) GetGlobalMarketData(convert string) (apitypes.GlobalMarketData, error) {
+	rate := rateOf(convert)
+	var marketCap, volume float64
+	for _, item := range fixtures {
+		marketCap += item.MarketCap
+		volume += item.Volume24H
+	}
+
+	return apitypes.GlobalMarketData{
+		TotalMarketCapUSD:            marketCap * rate,
+		Total24HVolumeUSD:            volume * rate,
+		BitcoinPercentageOfMarketCap: fixtures[0].MarketCap / marketCap * 100,
+		ActiveCurrencies:             len(fixtures),
+	}, nil
+}
+
+// Price returns the current price of the coin
+func (s *Service) Price(name string, convert string) (float64, error) {
+	item, ok := findFixture(name)
+	if !ok {
+		return 0, ErrNotFound
+	}
+	return util.FormatPrice(item.Price*rateOf(convert), convert), nil
+}
+
+// CoinLink returns the URL link for the coin
+func (s *Service) CoinLink(name string) string {
+	if item, ok := findFixture(name); ok {
+		return fmt.Sprintf("https://www.coingecko.com/en/coins/%s", item.ID)
+	}
+	return ""
+}
+
+// SupportedCurrencies returns a list of supported currencies
+func (s *Service) SupportedCurrencies() []string {
+
+	// keep these in alphabetical order
+	return []string{
+		"BTC",
+		"ETH",
+		"EUR",
+		"GBP",
+		"JPY",
+		"USD",
+	}
+}
+
+// RefreshCoinIDs does nothing since the demo coins never change
+func (s *Service) RefreshCoinIDs() error {
+	return nil
+}
+
+// fixturesToCoins converts the fixtures to coins in the currency
+func (s *Service) fixturesToCoins(list []fixture, convert string) []apitypes.Coin {
+	rate := rateOf(convert)
+	lastUpdated := time.Now().UTC().Format(time.RFC3339)
+	var ret []apitypes.Coin
+	for _, item := range list {
+		price := item.Price * rate
+		ret = append(ret, apitypes.Coin{
+			ID:               util.FormatID(item.ID),
+			Name:             util.FormatName(item.Name),
+			Symbol:           util.FormatSymbol(item.Symbol),
+			Rank:             rankOf(item.ID),
+			AvailableSupply:  util.FormatSupply(item.AvailableSupply),
+			TotalSupply:      util.FormatSupply(item.TotalSupply),
+			MaxSupply:        util.FormatSupply(item.MaxSupply),
+			MarketCap:        util.FormatMarketCap(item.MarketCap * rate),
+			FDV:              util.FormatMarketCap(item.TotalSupply * price),
+			High24H:          util.FormatPrice(price*1.015, convert),
+			Low24H:           util.FormatPrice(price*0.985, convert),
+			ATH:              util.FormatPrice(item.ATH*rate, convert),
+			PercentFromATH:   util.FormatPercentChange((item.Price - item.ATH) / item.ATH * 100),
+			Price:            util.FormatPrice(price, convert),
+			PercentChange1H:  util.FormatPercentChange(item.PercentChange1H),
+			PercentChange24H: util.FormatPercentChange(item.PercentChange24H),
+			PercentChange7D:  util.FormatPercentChange(item.PercentChange7D),
+			PercentChange30D: util.FormatPercentChange(item.PercentChange30D),
+			Volume24H:        util.FormatVolume(item.Volume24H * rate),
+			LastUpdated:      util.FormatLastUpdated(lastUpdated),
+			DateAdded:        util.FormatDateAdded(item.DateAdded),
+		})
+	}
+
+	return ret
+}
+
+// findFixture returns the fixture by ID, name or symbol
+func findFixture(name string) (fixture, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, item := range fixtures {
+		if name == item.ID || name == strings.ToLower(item.Name) || name == strings.ToLower(item.Symbol) || name == util.NameToSlug(item.Name) {
+			return item, true
+		}
+	}
+	return fixture{}, false
+}
+
+// rankOf returns the rank of the fixture
+func rankOf(id string) int {
+	for i, item := range fixtures {
+		if item.ID == id {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// rateOf returns the exchange rate of the currency from USD. Unsupported currencies are USD
+func rateOf(convert string) float64 {
+	if rate, ok := rates[strings.ToUpper(convert)]; ok {
+		return rate
+	}
+	return 1
+}
+
+// phaseOf returns the phase of the generated graph of the coin
+func phaseOf(id string) float64 {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return float64(h.Sum32()%1000) / 1000 * 2 * math.Pi
+}
+
+// multiplier returns the factor of the value at n points before the latest one, which is always 1 so the
+// generated graphs end at the current values
+func multiplier(n int, phase float64) float64 {
+	x := float64(n)
+	return 1 + 0.06*(math.Sin(x*0.07+phase)-math.Sin(phase)) + 0.02*(math.Sin(x*0.31+2*phase)-math.Sin(2*phase))
+}
@@ -0,0 +1,50 @@
+package demo
+
+// fixture is a coin of the demo data with its values in USD
+type fixture struct {
+	ID               string
+	Name             string
+	Symbol           string
+	Price            float64
+	MarketCap        float64
+	Volume24H        float64
+	AvailableSupply  float64
+	TotalSupply      float64
+	MaxSupply        float64
+	ATH              float64
+	PercentChange1H  float64
+	PercentChange24H float64
+	PercentChange7D  float64
+	PercentChange30D float64
+	DateAdded        string
+}
+
+// fixtures are the coins of the demo data ordered by rank
+var fixtures = []fixture{
+	{"bitcoin", "Bitcoin", "BTC", 64250.12, 1266532000000, 28413000000, 19712000, 19712000, 21000000, 73750.07, 0.21, 1.84, 4.37, 9.12, "2013-04-28T00:00:00Z"},
+	{"ethereum", "Ethereum", "ETH", 3125.48, 375410000000, 14725000000, 120112000, 120112000, 0, 4878.26, -0.12, 2.41, 6.02, 11.47, "2015-08-07T00:00:00Z"},
+	{"tether", "Tether", "USDT", 1.0, 118920000000, 52310000000, 118920000000, 118920000000, 0, 1.32, 0.01, -0.02, 0.01, 0.03, "2015-02-25T00:00:00Z"},
+	{"binancecoin", "BNB", "BNB", 582.37, 85041000000, 1712000000, 146030000, 146030000, 200000000, 717.48, 0.34, -1.27, 2.88, -3.14, "2017-07-25T00:00:00Z"},
+	{"solana", "Solana", "SOL", 148.92, 68962000000, 2914000000, 463090000, 578220000, 0, 259.96, 0.87, 5.63, 12.41, 18.25, "2020-04-10T00:00:00Z"},
+	{"usd-coin", "USDC", "USDC", 1.0, 34210000000, 6215000000, 34210000000, 34210000000, 0, 1.17, 0.0, 0.01, -0.01, 0.0, "2018-10-08T00:00:00Z"},
+	{"ripple", "XRP", "XRP", 0.5312, 29380000000, 1122000000, 55310000000, 99990000000, 100000000000, 3.4, -0.45, -2.96, -5.18, -8.73, "2013-08-04T00:00:00Z"},
+	{"dogecoin", "Dogecoin", "DOGE", 0.1247, 18081000000, 986000000, 145000000000, 145000000000, 0, 0.7316, 1.12, 7.45, 15.92, 24.61, "2013-12-15T00:00:00Z"},
+	{"cardano", "Cardano", "ADA", 0.4518, 16103000000, 412000000, 35640000000, 45000000000, 45000000000, 3.09, -0.27, -1.84, 3.26, -6.47, "2017-10-01T00:00:00Z"},
+	{"avalanche-2", "Avalanche", "AVAX", 35.64, 14042000000, 498000000, 394000000, 446000000, 720000000, 144.96, 0.58, 3.92, -4.73, 7.81, "2020-09-22T00:00:00Z"},
+	{"polkadot", "Polkadot", "DOT", 6.92, 9953000000, 231000000, 1438000000, 1438000000, 0, 54.98, -0.64, -3.58, -7.24, -12.36, "2020-08-19T00:00:00Z"},
+	{"chainlink", "Chainlink", "LINK", 14.27, 8379000000, 387000000, 587100000, 1000000000, 1000000000, 52.7, 0.43, 4.16, 9.58, 2.17, "2017-09-20T00:00:00Z"},
+	{"litecoin", "Litecoin", "LTC", 82.15, 6131000000, 412000000, 74630000, 74630000, 84000000, 410.26, -0.18, -0.92, 1.47, -2.85, "2013-04-28T00:00:00Z"},
+	{"uniswap", "Uniswap", "UNI", 7.84, 4703000000, 148000000, 599800000, 1000000000, 1000000000, 44.92, 0.92, 6.27, 11.35, 21.08, "2020-09-17T00:00:00Z"},
+	{"stellar", "Stellar", "XLM", 0.1068, 3094000000, 71000000, 28970000000, 50001000000, 50001000000, 0.8756, -0.31, -2.14, -3.92, -5.67, "2014-08-05T00:00:00Z"},
+	{"monero", "Monero", "XMR", 128.53, 2369000000, 64000000, 18430000, 18430000, 0, 517.62, 0.15, 1.03, -1.86, 4.29, "2014-05-21T00:00:00Z"},
+}
+
+// rates are the exchange rates of the supported currencies from USD
+var rates = map[string]float64{
+	"BTC": 1 / 64250.12,
+	"ETH": 1 / 3125.48,
+	"EUR": 0.9214,
+	"GBP": 0.7862,
+	"JPY": 149.53,
+	"USD": 1,
+}
@@ -9,14 +9,22 @@ type Interface interface {
 	Ping() error
 	GetAllCoinData(convert string, ch chan []types.Coin) error
 	GetCoinGraphData(convert string, symbol string, name string, start int64, end int64) (types.CoinGraph, error)
+	GetCoinOHLCData(convert string, symbol string, name string, start int64, end int64) (types.CoinOHLC, error)
 	GetGlobalMarketGraphData(convert string, start int64, end int64) (types.MarketGraph, error)
 	GetGlobalMarketData(convert string) (types.GlobalMarketData, error)
 	GetCoinData(name string, convert string) (types.Coin, error)
 	GetCoinDataBatch(names []string, convert string) ([]types.Coin, error)
+	GetRecentlyAddedCoinData(convert string) ([]types.Coin, error)
 	//GetAltcoinMarketGraphData(start int64, end int64) (types.MarketGraph, error)
 	//GetCoinPriceUSD(coin string) (float64, error)
 	//GetCoinMarkets(coin string) ([]types.Market, error)
 	CoinLink(name string) string
 	SupportedCurrencies() []string
 	Price(name string, convert string) (float64, error)
+	RefreshCoinIDs() error
+}
+
+// SparklineInterface is implemented by APIs that can return the 7 day sparkline of the coins
+type SparklineInterface interface {
+	SetSparkline(enabled bool)
 }
@@ -9,13 +9,22 @@ type Coin struct {
 	Price            float64 `json:"price"`
 	Volume24H        float64 `json:"volume24H"`
 	MarketCap        float64 `json:"marketCap"`
+	FDV              float64 `json:"fdv"`
+	High24H          float64 `json:"high24H"`
+	Low24H           float64 `json:"low24H"`
+	ATH              float64 `json:"ath"`
+	PercentFromATH   float64 `json:"percentFromATH"`
 	AvailableSupply  float64 `json:"availableSupply"`
 	TotalSupply      float64 `json:"totalSupply"`
+	MaxSupply        float64 `json:"maxSupply"`
 	PercentChange1H  float64 `json:"percentChange1H"`
 	PercentChange24H float64 `json:"percentChange24H"`
 	PercentChange7D  float64 `json:"percentChange7D"`
 	PercentChange30D float64 `json:"percentChange30D"`
 	LastUpdated      string  `json:"lastUpdated"`
+	DateAdded        string  `json:"dateAdded"`
+	// prices of the last 7 days, oldest first. Only returned when sparklines are enabled
+	Sparkline7D []float64 `json:"sparkline7D"`
 }
 
 // GlobalMarketData struct
@@ -36,6 +45,12 @@ type CoinGraph struct {
 	Volume                     [][]float64 `json:"volume"`
 }
 
+// CoinOHLC struct
+type CoinOHLC struct {
+	// Candles are rows of timestamp, open, high, low and close values sorted by timestamp
+	Candles [][]float64 `json:"candles"`
+}
+
 // Market struct
 type Market struct {
 	Rank          int     `json:"rank"`
@@ -98,6 +98,16 @@ func FormatLastUpdated(lastUpdated string) string {
 	return strconv.Itoa(int(lastUpdatedTime.Unix()))
 }
 
+// FormatDateAdded formats the date added value
+func FormatDateAdded(dateAdded string) string {
+	dateAddedTime, err := time.Parse(time.RFC3339, dateAdded)
+	if err != nil {
+		return ""
+	}
+
+	return strconv.Itoa(int(dateAddedTime.Unix()))
+}
+
 // CalcDays calculates the number of days between two timestamps
 func CalcDays(start, end int64) int {
 	return int(time.Unix(end, 0).Sub(time.Unix(start, 0)).Hours() / 24)
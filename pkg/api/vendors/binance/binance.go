@@ -0,0 +1,88 @@
+// Package binance is a client for the Binance market data streams
+package binance
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+var streamURL = "wss://stream.binance.com:9443/stream"
+
+// dialTimeout is the max time to connect to the stream
+var dialTimeout = 10 * time.Second
+
+// readTimeout is the max time without a message before the stream is considered dead. The server pings every
+// few minutes so this is longer than the ping interval
+var readTimeout = 5 * time.Minute
+
+// maxMessageSize is the max size of a message in bytes
+const maxMessageSize = 1 << 20
+
+// MiniTicker is the 24 hour rolling window ticker of a pair
+type MiniTicker struct {
+	Event       string `json:"e"`
+	EventTime   int64  `json:"E"`
+	Symbol      string `json:"s"`
+	Close       string `json:"c"`
+	Open        string `json:"o"`
+	High        string `json:"h"`
+	Low         string `json:"l"`
+	Volume      string `json:"v"`
+	QuoteVolume string `json:"q"`
+}
+
+// streamMessage is a message of a combined stream
+type streamMessage struct {
+	Stream string     `json:"stream"`
+	Data   MiniTicker `json:"data"`
+}
+
+// MiniTickerStream is a stream of the mini tickers of pairs
+type MiniTickerStream struct {
+	conn *websocket.Conn
+}
+
+// NewMiniTickerStream opens a stream of the mini tickers of the pairs, e.g. "btcusdt"
+func NewMiniTickerStream(pairs []string) (*MiniTickerStream, error) {
+	var streams []string
+	for _, pair := range pairs {
+		streams = append(streams, strings.ToLower(pair)+"@miniTicker")
+	}
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: dialTimeout,
+	}
+	conn, _, err := dialer.Dial(streamURL+"?streams="+strings.Join(streams, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetReadLimit(maxMessageSize)
+
+	return &MiniTickerStream{
+		conn: conn,
+	}, nil
+}
+
+// Next blocks until the next mini ticker
+func (s *MiniTickerStream) Next() (*MiniTicker, error) {
+	s.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	_, b, err := s.conn.ReadMessage()
+	if err != nil {
+		return nil, err
+	}
+	var msg streamMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return nil, err
+	}
+
+	return &msg.Data, nil
+}
+
+// Close closes the stream
+func (s *MiniTickerStream) Close() error {
+	return s.conn.Close()
+}
@@ -0,0 +1,51 @@
+package binance
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// TestMiniTickerStream checks that the stream subscribes to the mini tickers of the pairs and reads the tickers of
+// the combined stream messages
+func TestMiniTickerStream(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if streams := r.URL.Query().Get("streams"); streams != "btcusdt@miniTicker/ethusdt@miniTicker" {
+			t.Errorf("streams == %q, want btcusdt@miniTicker/ethusdt@miniTicker", streams)
+		}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		msg := `{"stream":"btcusdt@miniTicker","data":{"e":"24hrMiniTicker","s":"BTCUSDT","c":"50000.10","o":"49000.00"}}`
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(msg)); err != nil {
+			t.Error(err)
+		}
+		conn.ReadMessage()
+	}))
+	defer server.Close()
+
+	defaultStreamURL := streamURL
+	streamURL = "ws" + strings.TrimPrefix(server.URL, "http")
+	defer func() { streamURL = defaultStreamURL }()
+
+	stream, err := NewMiniTickerStream([]string{"BTCUSDT", "ETHUSDT"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+
+	ticker, err := stream.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticker.Symbol != "BTCUSDT" || ticker.Close != "50000.10" || ticker.Open != "49000.00" {
+		t.Errorf("ticker == %+v, want BTCUSDT closing at 50000.10 from 49000.00", ticker)
+	}
+}
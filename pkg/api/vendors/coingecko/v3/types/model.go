@@ -71,6 +71,9 @@ type LinksItem map[string]interface{}
 // ChartItem ...
 type ChartItem [2]float32
 
+// OHLCItem is the timestamp, open, high, low and close of a candle
+type OHLCItem [5]float64
+
 // MarketDataItem map all market data item
 type MarketDataItem struct {
 	CurrentPrice                           AllCurrencies     `json:"current_price"`
@@ -197,6 +200,12 @@ type CoinsListItem struct {
 	coinBaseStruct
 }
 
+// CoinsListNewItem item in CoinListNew
+type CoinsListNewItem struct {
+	coinBaseStruct
+	ActivatedAt int64 `json:"activated_at"`
+}
+
 // CoinsMarketItem item in CoinMarket
 type CoinsMarketItem struct {
 	coinBaseStruct
@@ -204,6 +213,7 @@ type CoinsMarketItem struct {
 	CurrentPrice                        float64        `json:"current_price"`
 	MarketCap                           float64        `json:"market_cap"`
 	MarketCapRank                       int16          `json:"market_cap_rank"`
+	FullyDilutedValuation               *float64       `json:"fully_diluted_valuation"`
 	TotalVolume                         float64        `json:"total_volume"`
 	High24                              float64        `json:"high_24h"`
 	Low24                               float64        `json:"low_24h"`
@@ -213,6 +223,7 @@ type CoinsMarketItem struct {
 	MarketCapChangePercentage24h        float64        `json:"market_cap_change_percentage_24h"`
 	CirculatingSupply                   float64        `json:"circulating_supply"`
 	TotalSupply                         float64        `json:"total_supply"`
+	MaxSupply                           *float64       `json:"max_supply"`
 	ATH                                 float64        `json:"ath"`
 	ATHChangePercentage                 float64        `json:"ath_change_percentage"`
 	ATHDate                             string         `json:"ath_date"`
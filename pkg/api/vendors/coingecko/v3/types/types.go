@@ -18,6 +18,9 @@ type SimpleSupportedVSCurrencies []string
 // CoinList https://api.coingecko.com/api/v3/coins/list
 type CoinList []CoinsListItem
 
+// CoinListNew https://api.coingecko.com/api/v3/coins/list/new
+type CoinListNew []CoinsListNewItem
+
 // CoinsMarket https://api.coingecko.com/api/v3/coins/markets?vs_currency=usd&order=market_cap_desc&per_page=100&page=1&sparkline=false
 type CoinsMarket []CoinsMarketItem
 
@@ -65,6 +68,9 @@ type CoinsIDHistory struct {
 	PublicInterest *PublicInterestItem `json:"public_interest_stats"`
 }
 
+// CoinsIDOHLC https://api.coingecko.com/api/v3/coins/bitcoin/ohlc?vs_currency=usd&days=1
+type CoinsIDOHLC []OHLCItem
+
 // CoinsIDMarketChart https://api.coingecko.com/api/v3/coins/bitcoin/market_chart?vs_currency=usd&days=1
 type CoinsIDMarketChart struct {
 	coinBaseStruct
@@ -13,11 +13,24 @@ import (
 	"github.com/miguelmota/cointop/pkg/api/vendors/coingecko/v3/types"
 )
 
-var baseURL = "https://api.coingecko.com/api/v3"
+// DefaultBaseURL is the base URL of the API
+const DefaultBaseURL = "https://api.coingecko.com/api/v3"
 
 // Client struct
 type Client struct {
 	httpClient *http.Client
+	baseURL    string
+}
+
+// StatusError is the error of a response with a status that isn't ok. The message is the response body
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+// Error returns the response body
+func (e *StatusError) Error() string {
+	return e.Body
 }
 
 // NewClient create new client object
@@ -25,7 +38,15 @@ func NewClient(httpClient *http.Client) *Client {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
-	return &Client{httpClient: httpClient}
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    DefaultBaseURL,
+	}
+}
+
+// SetBaseURL sets the base URL of the requests, eg. of a proxy
+func (c *Client) SetBaseURL(baseURL string) {
+	c.baseURL = strings.TrimSuffix(baseURL, "/")
 }
 
 // helper
@@ -41,7 +62,7 @@ func doReq(req *http.Request, client *http.Client) ([]byte, error) {
 		return nil, err
 	}
 	if 200 != resp.StatusCode {
-		return nil, fmt.Errorf("%s", body)
+		return nil, &StatusError{StatusCode: resp.StatusCode, Body: string(body)}
 	}
 	return body, nil
 }
@@ -64,7 +85,7 @@ func (c *Client) MakeReq(url string) ([]byte, error) {
 
 // Ping /ping endpoint
 func (c *Client) Ping() (*types.Ping, error) {
-	url := fmt.Sprintf("%s/ping", baseURL)
+	url := fmt.Sprintf("%s/ping", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -100,7 +121,7 @@ func (c *Client) SimplePrice(ids []string, vsCurrencies []string) (*map[string]m
 	params.Add("ids", idsParam)
 	params.Add("vs_currencies", vsCurrenciesParam)
 
-	url := fmt.Sprintf("%s/simple/price?%s", baseURL, params.Encode())
+	url := fmt.Sprintf("%s/simple/price?%s", c.baseURL, params.Encode())
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -117,7 +138,7 @@ func (c *Client) SimplePrice(ids []string, vsCurrencies []string) (*map[string]m
 
 // SimpleSupportedVSCurrencies /simple/supported_vs_currencies
 func (c *Client) SimpleSupportedVSCurrencies() (*types.SimpleSupportedVSCurrencies, error) {
-	url := fmt.Sprintf("%s/simple/supported_vs_currencies", baseURL)
+	url := fmt.Sprintf("%s/simple/supported_vs_currencies", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -133,7 +154,7 @@ func (c *Client) SimpleSupportedVSCurrencies() (*types.SimpleSupportedVSCurrenci
 
 // CoinsList /coins/list
 func (c *Client) CoinsList() (*types.CoinList, error) {
-	url := fmt.Sprintf("%s/coins/list", baseURL)
+	url := fmt.Sprintf("%s/coins/list", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -147,6 +168,22 @@ func (c *Client) CoinsList() (*types.CoinList, error) {
 	return data, nil
 }
 
+// CoinsListNew /coins/list/new
+func (c *Client) CoinsListNew() (*types.CoinListNew, error) {
+	url := fmt.Sprintf("%s/coins/list/new", c.baseURL)
+	resp, err := c.MakeReq(url)
+	if err != nil {
+		return nil, err
+	}
+
+	var data *types.CoinListNew
+	err = json.Unmarshal(resp, &data)
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
 // CoinsMarket /coins/market
 func (c *Client) CoinsMarket(vsCurrency string, ids []string, order string, perPage int, page int, sparkline bool, priceChangePercentage []string) (*types.CoinsMarket, error) {
 	if len(vsCurrency) == 0 {
@@ -178,7 +215,7 @@ func (c *Client) CoinsMarket(vsCurrency string, ids []string, order string, perP
 		priceChangePercentageParam := strings.Join(priceChangePercentage[:], ",")
 		params.Add("price_change_percentage", priceChangePercentageParam)
 	}
-	url := fmt.Sprintf("%s/coins/markets?%s", baseURL, params.Encode())
+	url := fmt.Sprintf("%s/coins/markets?%s", c.baseURL, params.Encode())
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -204,7 +241,7 @@ func (c *Client) CoinsID(id string, localization bool, tickers bool, marketData
 	params.Add("community_data", format.Bool2String(communityData))
 	params.Add("developer_data", format.Bool2String(developerData))
 	params.Add("sparkline", format.Bool2String(sparkline))
-	url := fmt.Sprintf("%s/coins/%s?%s", baseURL, id, params.Encode())
+	url := fmt.Sprintf("%s/coins/%s?%s", c.baseURL, id, params.Encode())
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -227,7 +264,7 @@ func (c *Client) CoinsIDTickers(id string, page int) (*types.CoinsIDTickers, err
 	if page > 0 {
 		params.Add("page", format.Int2String(page))
 	}
-	url := fmt.Sprintf("%s/coins/%s/tickers?%s", baseURL, id, params.Encode())
+	url := fmt.Sprintf("%s/coins/%s/tickers?%s", c.baseURL, id, params.Encode())
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -249,7 +286,7 @@ func (c *Client) CoinsIDHistory(id string, date string, localization bool) (*typ
 	params.Add("date", date)
 	params.Add("localization", format.Bool2String(localization))
 
-	url := fmt.Sprintf("%s/coins/%s/history?%s", baseURL, id, params.Encode())
+	url := fmt.Sprintf("%s/coins/%s/history?%s", c.baseURL, id, params.Encode())
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -264,6 +301,12 @@ func (c *Client) CoinsIDHistory(id string, date string, localization bool) (*typ
 
 // CoinsIDMarketChart /coins/{id}/market_chart?vsCurrency={usd, eur, jpy, etc.}&days={1,14,30,max}
 func (c *Client) CoinsIDMarketChart(id string, vsCurrency string, days string) (*types.CoinsIDMarketChart, error) {
+	return c.CoinsIDMarketChartInterval(id, vsCurrency, days, "")
+}
+
+// CoinsIDMarketChartInterval /coins/{id}/market_chart?vsCurrency={usd, eur, jpy, etc.}&days={1,14,30,max}&interval={hourly,daily}
+// An empty interval lets the API pick the granularity from the number of days
+func (c *Client) CoinsIDMarketChartInterval(id string, vsCurrency string, days string, interval string) (*types.CoinsIDMarketChart, error) {
 	if len(id) == 0 || len(vsCurrency) == 0 || len(days) == 0 {
 		return nil, fmt.Errorf("id, vsCurrency, and days is required")
 	}
@@ -271,8 +314,11 @@ func (c *Client) CoinsIDMarketChart(id string, vsCurrency string, days string) (
 	params := url.Values{}
 	params.Add("vs_currency", vsCurrency)
 	params.Add("days", days)
+	if interval != "" {
+		params.Add("interval", interval)
+	}
 
-	url := fmt.Sprintf("%s/coins/%s/market_chart?%s", baseURL, id, params.Encode())
+	url := fmt.Sprintf("%s/coins/%s/market_chart?%s", c.baseURL, id, params.Encode())
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -287,11 +333,37 @@ func (c *Client) CoinsIDMarketChart(id string, vsCurrency string, days string) (
 	return &m, nil
 }
 
+// CoinsIDOHLC /coins/{id}/ohlc?vs_currency={usd, eur, jpy, etc.}&days={1,7,14,30,90,180,365,max}
+// The API picks the candle size from the number of days
+func (c *Client) CoinsIDOHLC(id string, vsCurrency string, days string) (*types.CoinsIDOHLC, error) {
+	if len(id) == 0 || len(vsCurrency) == 0 || len(days) == 0 {
+		return nil, fmt.Errorf("id, vsCurrency, and days is required")
+	}
+
+	params := url.Values{}
+	params.Add("vs_currency", vsCurrency)
+	params.Add("days", days)
+
+	url := fmt.Sprintf("%s/coins/%s/ohlc?%s", c.baseURL, id, params.Encode())
+	resp, err := c.MakeReq(url)
+	if err != nil {
+		return nil, err
+	}
+
+	m := types.CoinsIDOHLC{}
+	err = json.Unmarshal(resp, &m)
+	if err != nil {
+		return &m, err
+	}
+
+	return &m, nil
+}
+
 // CoinsIDStatusUpdates
 
 // CoinsIDContractAddress https://api.coingecko.com/api/v3/coins/{id}/contract/{contract_address}
 // func CoinsIDContractAddress(id string, address string) (nil, error) {
-// 	url := fmt.Sprintf("%s/coins/%s/contract/%s", baseURL, id, address)
+// 	url := fmt.Sprintf("%s/coins/%s/contract/%s", c.baseURL, id, address)
 // 	resp, err := request.MakeReq(url)
 // 	if err != nil {
 // 		return nil, err
@@ -300,7 +372,7 @@ func (c *Client) CoinsIDMarketChart(id string, vsCurrency string, days string) (
 
 // EventsCountries https://api.coingecko.com/api/v3/events/countries
 func (c *Client) EventsCountries() ([]types.EventCountryItem, error) {
-	url := fmt.Sprintf("%s/events/countries", baseURL)
+	url := fmt.Sprintf("%s/events/countries", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -316,7 +388,7 @@ func (c *Client) EventsCountries() ([]types.EventCountryItem, error) {
 
 // EventsTypes https://api.coingecko.com/api/v3/events/types
 func (c *Client) EventsTypes() (*types.EventsTypes, error) {
-	url := fmt.Sprintf("%s/events/types", baseURL)
+	url := fmt.Sprintf("%s/events/types", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -332,7 +404,7 @@ func (c *Client) EventsTypes() (*types.EventsTypes, error) {
 
 // ExchangeRates https://api.coingecko.com/api/v3/exchange_rates
 func (c *Client) ExchangeRates() (*types.ExchangeRatesItem, error) {
-	url := fmt.Sprintf("%s/exchange_rates", baseURL)
+	url := fmt.Sprintf("%s/exchange_rates", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
@@ -347,7 +419,7 @@ func (c *Client) ExchangeRates() (*types.ExchangeRatesItem, error) {
 
 // Global https://api.coingecko.com/api/v3/global
 func (c *Client) Global() (*types.Global, error) {
-	url := fmt.Sprintf("%s/global", baseURL)
+	url := fmt.Sprintf("%s/global", c.baseURL)
 	resp, err := c.MakeReq(url)
 	if err != nil {
 		return nil, err
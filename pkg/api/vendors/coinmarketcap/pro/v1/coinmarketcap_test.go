@@ -0,0 +1,44 @@
+package coinmarketcap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestCustomBaseURL checks that requests go to the custom base URL with the API key header
+func TestCustomBaseURL(t *testing.T) {
+	var gotPath, gotKey string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotKey = r.Header.Get("X-CMC_PRO_API_KEY")
+		w.Write([]byte(`{"status":{},"data":{"btc_dominance":60.5}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(&Config{
+		ProAPIKey: "test-key",
+		BaseURL:   server.URL + "/v1/",
+	})
+	metrics, err := client.GlobalMetrics.LatestQuotes(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotPath != "/v1/global-metrics/quotes/latest" {
+		t.Errorf("request path == %q, want %q", gotPath, "/v1/global-metrics/quotes/latest")
+	}
+	if gotKey != "test-key" {
+		t.Errorf("api key header == %q, want %q", gotKey, "test-key")
+	}
+	if metrics.BTCDominance != 60.5 {
+		t.Errorf("btc dominance == %v, want %v", metrics.BTCDominance, 60.5)
+	}
+}
+
+// TestDefaultBaseURL checks that the production base URL is used by default
+func TestDefaultBaseURL(t *testing.T) {
+	client := NewClient(nil)
+	if client.baseURL != DefaultBaseURL {
+		t.Errorf("baseURL == %q, want %q", client.baseURL, DefaultBaseURL)
+	}
+}
@@ -0,0 +1,203 @@
+// Package coinpaprika is a client for the CoinPaprika API v1
+package coinpaprika
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// DefaultBaseURL is the base URL of the API
+const DefaultBaseURL = "https://api.coinpaprika.com/v1"
+
+// Client struct
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// Coin is an item of the coins list
+type Coin struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Rank     int    `json:"rank"`
+	IsNew    bool   `json:"is_new"`
+	IsActive bool   `json:"is_active"`
+	Type     string `json:"type"`
+}
+
+// Quote is the market data of a ticker in a currency
+type Quote struct {
+	Price                 float64 `json:"price"`
+	Volume24h             float64 `json:"volume_24h"`
+	MarketCap             float64 `json:"market_cap"`
+	FullyDilutedMarketCap float64 `json:"fully_diluted_market_cap"`
+	PercentChange1h       float64 `json:"percent_change_1h"`
+	PercentChange24h      float64 `json:"percent_change_24h"`
+	PercentChange7d       float64 `json:"percent_change_7d"`
+	PercentChange30d      float64 `json:"percent_change_30d"`
+	ATHPrice              float64 `json:"ath_price"`
+	ATHDate               string  `json:"ath_date"`
+	PercentFromPriceATH   float64 `json:"percent_from_price_ath"`
+}
+
+// Ticker is the market data of a coin
+type Ticker struct {
+	ID                string           `json:"id"`
+	Name              string           `json:"name"`
+	Symbol            string           `json:"symbol"`
+	Rank              int              `json:"rank"`
+	CirculatingSupply float64          `json:"circulating_supply"`
+	TotalSupply       float64          `json:"total_supply"`
+	MaxSupply         float64          `json:"max_supply"`
+	FirstDataAt       string           `json:"first_data_at"`
+	LastUpdated       string           `json:"last_updated"`
+	Quotes            map[string]Quote `json:"quotes"`
+}
+
+// HistoricalTicker is the market data of a coin at a point in time
+type HistoricalTicker struct {
+	Timestamp string  `json:"timestamp"`
+	Price     float64 `json:"price"`
+	Volume24h float64 `json:"volume_24h"`
+	MarketCap float64 `json:"market_cap"`
+}
+
+// OHLCV is the open, high, low, close and volume of a coin over a day
+type OHLCV struct {
+	TimeOpen  string  `json:"time_open"`
+	TimeClose string  `json:"time_close"`
+	Open      float64 `json:"open"`
+	High      float64 `json:"high"`
+	Low       float64 `json:"low"`
+	Close     float64 `json:"close"`
+	Volume    float64 `json:"volume"`
+	MarketCap float64 `json:"market_cap"`
+}
+
+// Global is the global market data
+type Global struct {
+	MarketCapUSD               float64 `json:"market_cap_usd"`
+	Volume24hUSD               float64 `json:"volume_24h_usd"`
+	BitcoinDominancePercentage float64 `json:"bitcoin_dominance_percentage"`
+	CryptocurrenciesNumber     int     `json:"cryptocurrencies_number"`
+	LastUpdated                int64   `json:"last_updated"`
+}
+
+// NewClient create new client object
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    DefaultBaseURL,
+	}
+}
+
+// SetBaseURL sets the base URL of the requests, eg. of a proxy
+func (c *Client) SetBaseURL(baseURL string) {
+	c.baseURL = strings.TrimSuffix(baseURL, "/")
+}
+
+// MakeReq HTTP request helper
+func (c *Client) MakeReq(url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s", body)
+	}
+	return body, nil
+}
+
+// get makes the request and decodes the JSON response into data
+func (c *Client) get(url string, data interface{}) error {
+	resp, err := c.MakeReq(url)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resp, data)
+}
+
+// Global /global endpoint
+func (c *Client) Global() (*Global, error) {
+	var data *Global
+	if err := c.get(fmt.Sprintf("%s/global", c.baseURL), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Coins /coins endpoint
+func (c *Client) Coins() ([]Coin, error) {
+	var data []Coin
+	if err := c.get(fmt.Sprintf("%s/coins", c.baseURL), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Tickers /tickers endpoint. It returns the tickers of all the active coins with quotes in the currencies
+func (c *Client) Tickers(quotes []string) ([]Ticker, error) {
+	params := url.Values{}
+	params.Add("quotes", strings.ToUpper(strings.Join(quotes, ",")))
+	var data []Ticker
+	if err := c.get(fmt.Sprintf("%s/tickers?%s", c.baseURL, params.Encode()), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Ticker /tickers/{coin_id} endpoint
+func (c *Client) Ticker(id string, quotes []string) (*Ticker, error) {
+	params := url.Values{}
+	params.Add("quotes", strings.ToUpper(strings.Join(quotes, ",")))
+	var data *Ticker
+	if err := c.get(fmt.Sprintf("%s/tickers/%s?%s", c.baseURL, url.PathEscape(id), params.Encode()), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// TickersHistorical /tickers/{coin_id}/historical endpoint. Start and end are unix times in seconds
+func (c *Client) TickersHistorical(id string, quote string, start int64, end int64, interval string) ([]HistoricalTicker, error) {
+	params := url.Values{}
+	params.Add("start", fmt.Sprintf("%d", start))
+	params.Add("end", fmt.Sprintf("%d", end))
+	params.Add("quote", strings.ToLower(quote))
+	params.Add("interval", interval)
+	var data []HistoricalTicker
+	if err := c.get(fmt.Sprintf("%s/tickers/%s/historical?%s", c.baseURL, url.PathEscape(id), params.Encode()), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// OHLCVHistorical /coins/{coin_id}/ohlcv/historical endpoint. Start and end are unix times in seconds and
+// the candles are daily
+func (c *Client) OHLCVHistorical(id string, quote string, start int64, end int64) ([]OHLCV, error) {
+	params := url.Values{}
+	params.Add("start", fmt.Sprintf("%d", start))
+	params.Add("end", fmt.Sprintf("%d", end))
+	params.Add("quote", strings.ToLower(quote))
+	var data []OHLCV
+	if err := c.get(fmt.Sprintf("%s/coins/%s/ohlcv/historical?%s", c.baseURL, url.PathEscape(id), params.Encode()), &data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,56 @@
+package coinpaprika
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestTicker checks that the ticker of a coin is requested with the quotes and decoded
+func TestTicker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/tickers/btc-bitcoin" {
+			t.Errorf("path == %q, want /v1/tickers/btc-bitcoin", r.URL.Path)
+		}
+		if quotes := r.URL.Query().Get("quotes"); quotes != "USD,EUR" {
+			t.Errorf("quotes == %q, want USD,EUR", quotes)
+		}
+		w.Write([]byte(`{"id": "btc-bitcoin", "name": "Bitcoin", "symbol": "BTC", "rank": 1, "quotes": {"USD": {"price": 50000.5}, "EUR": {"price": 42000}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(server.URL + "/v1/")
+	ticker, err := client.Ticker("btc-bitcoin", []string{"usd", "eur"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticker.ID != "btc-bitcoin" || ticker.Rank != 1 {
+		t.Errorf("ticker == %s %d, want btc-bitcoin 1", ticker.ID, ticker.Rank)
+	}
+	if price := ticker.Quotes["USD"].Price; price != 50000.5 {
+		t.Errorf("price == %v, want 50000.5", price)
+	}
+}
+
+// TestError checks that a response that isn't ok is returned as an error
+func TestError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error": "id not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(nil)
+	client.SetBaseURL(server.URL)
+	if _, err := client.Ticker("missing", []string{"USD"}); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+// TestDefaultBaseURL checks that the production base URL is used by default
+func TestDefaultBaseURL(t *testing.T) {
+	client := NewClient(nil)
+	if client.baseURL != DefaultBaseURL {
+		t.Errorf("baseURL == %q, want %q", client.baseURL, DefaultBaseURL)
+	}
+}
@@ -2,15 +2,36 @@ package chartplot
 
 import (
 	"math"
+	"strconv"
+	"strings"
 
 	"github.com/miguelmota/cointop/pkg/termui"
 )
 
 // ChartPlot ...
 type ChartPlot struct {
-	t *termui.LineChart
+	t           *termui.LineChart
+	xLabels     []string
+	overlay     []float64
+	overlayMask [][]bool
+	logScale    bool
+	series      [][]float64
+	seriesMask  [][]int
+	labelY      func(float64) string
 }
 
+// lineColors tell apart the points of the data and each series. The overlay and axes colors aren't one of them
+var lineColors = []termui.Attribute{
+	termui.ColorCyan,
+	termui.ColorMagenta,
+	termui.ColorGreen,
+	termui.ColorBlue,
+	termui.ColorRed,
+}
+
+// MaxLines is the max number of lines plotted by a line chart, the data plus the series
+var MaxLines = len(lineColors)
+
 // NewChartPlot ...
 func NewChartPlot() *ChartPlot {
 	t := termui.NewLineChart()
@@ -18,6 +39,7 @@ func NewChartPlot() *ChartPlot {
 	// NOTE: empty list means don't show x-axis labels
 	t.DataLabels = []string{""}
 	t.Border = false
+	t.OverlayColor = termui.ColorYellow
 
 	return &ChartPlot{
 		t: t,
@@ -56,10 +78,73 @@ func (c *ChartPlot) SetData(data []float64) {
 	c.t.Data = data
 }
 
+// SetOverlay sets a second series, such as a moving average, drawn under the data line. The overlay
+// must have the same length as the data and NaN values aren't drawn
+func (c *ChartPlot) SetOverlay(data []float64) {
+	c.overlay = data
+}
+
+// SetLogScale sets whether the data and overlay are plotted on a log10 scale. The y-axis labels show the
+// values at their log spaced positions
+func (c *ChartPlot) SetLogScale(enabled bool) {
+	c.logScale = enabled
+}
+
+// SetSeries sets more series drawn as lines over the data on the same scale. Series past MaxLines aren't drawn
+func (c *ChartPlot) SetSeries(series [][]float64) {
+	c.series = series
+}
+
+// SeriesMask returns which line each of the points of the last GetChartPoints call is part of, 0 for the data and
+// n for the nth series, or -1 for points that aren't part of a line. It's nil when no series are set
+func (c *ChartPlot) SeriesMask() [][]int {
+	return c.seriesMask
+}
+
+// SetYLabelFormat sets the formatting of the y-axis labels
+func (c *ChartPlot) SetYLabelFormat(format func(float64) string) {
+	c.labelY = format
+}
+
+// OverlayMask returns which of the points of the last GetChartPoints call are part of the overlay
+func (c *ChartPlot) OverlayMask() [][]bool {
+	return c.overlayMask
+}
+
+// SetXLabels sets the labels shown under the start, middle and end of the x-axis
+func (c *ChartPlot) SetXLabels(start, middle, end string) {
+	c.xLabels = []string{start, middle, end}
+}
+
 // GetChartPoints ...
 func (c *ChartPlot) GetChartPoints(width int) [][]rune {
 	axisYWidth := 30
+	overlay := c.overlay
+	c.t.LabelY = c.labelY
+	if c.logScale {
+		c.t.Data = log10Data(c.t.Data)
+		overlay = log10Data(overlay)
+		c.t.LabelY = func(v float64) string {
+			return shortenPrice(math.Pow(10, v))
+		}
+	}
 	c.t.Data = interpolateData(c.t.Data, (width*2)-axisYWidth)
+	c.t.Overlay = nil
+	if len(overlay) > 0 {
+		c.t.Overlay = interpolateData(overlay, (width*2)-axisYWidth)
+	}
+	c.t.Series = nil
+	c.t.SeriesColors = nil
+	if len(c.series) > 0 {
+		c.t.LineColor = lineColors[0]
+		for i, series := range c.series {
+			if i+1 >= MaxLines {
+				break
+			}
+			c.t.Series = append(c.t.Series, interpolateData(series, (width*2)-axisYWidth))
+			c.t.SeriesColors = append(c.t.SeriesColors, lineColors[i+1])
+		}
+	}
 	termui.Body = termui.NewGrid()
 	termui.Body.Width = width
 	termui.Body.AddRows(
@@ -69,6 +154,8 @@ func (c *ChartPlot) GetChartPoints(width int) [][]rune {
 	)
 
 	var points [][]rune
+	var mask [][]bool
+	var seriesMask [][]int
 	// calculate layout
 	termui.Body.Align()
 	w := termui.Body.Width
@@ -77,16 +164,314 @@ func (c *ChartPlot) GetChartPoints(width int) [][]rune {
 	b := row.Buffer()
 	for i := 0; i < h; i = i + 1 {
 		var rowpoints []rune
+		var rowmask []bool
+		var rowseries []int
 		for j := 0; j < w; j = j + 1 {
 			p := b.At(j, i)
 			rowpoints = append(rowpoints, p.Ch)
+			rowmask = append(rowmask, c.t.Overlay != nil && p.Fg == c.t.OverlayColor)
+			rowseries = append(rowseries, lineIndex(p.Fg))
 		}
 		points = append(points, rowpoints)
+		mask = append(mask, rowmask)
+		seriesMask = append(seriesMask, rowseries)
+	}
+	c.overlayMask = mask
+	c.seriesMask = nil
+	if len(c.t.Series) > 0 {
+		c.seriesMask = seriesMask
+	}
+
+	if len(c.xLabels) == 3 {
+		addXLabels(points, c.xLabels, len(c.t.Data)/2)
+	}
+
+	return points
+}
+
+// lineIndex returns the index of the line drawn in the color, or -1 if no line is
+func lineIndex(color termui.Attribute) int {
+	for i, c := range lineColors {
+		if c == color {
+			return i
+		}
+	}
+	return -1
+}
+
+// addXLabels writes the labels under the start, middle and end of the plotted data of a line chart and draws
+// gridlines above the middle and end labels. The middle label is dropped when there isn't room for it
+func addXLabels(points [][]rune, labels []string, dataWidth int) {
+	// NOTE: the origin of the axes is found in the points since its position depends on the y-axis labels
+	originX, originY := -1, -1
+	for y := range points {
+		for x, p := range points[y] {
+			if p == termui.ORIGIN {
+				originX, originY = x, y
+			}
+		}
+	}
+	labelY := originY + 1
+	if originX < 0 || labelY >= len(points) {
+		return
+	}
+
+	row := points[labelY]
+	startX := originX + 1
+	endX := originX + dataWidth
+	if endX >= len(row) {
+		endX = len(row) - 1
+	}
+	start, middle, end := []rune(labels[0]), []rune(labels[1]), []rune(labels[2])
+	startEnd := startX + len(start)
+	endStart := endX - len(end) + 1
+	if endStart <= startEnd {
+		// NOTE: only the end label fits
+		start, middle = nil, nil
+		startEnd = startX
+		if endStart < startX {
+			return
+		}
+	}
+	middleX := (startX + endX) / 2
+	middleStart := middleX - len(middle)/2
+	if middleStart <= startEnd || middleStart+len(middle) >= endStart {
+		middle = nil
+	}
+
+	copy(row[startX:], start)
+	copy(row[endStart:], end)
+	gridlines := []int{endX}
+	if len(middle) > 0 {
+		copy(row[middleStart:], middle)
+		gridlines = append(gridlines, middleX)
+	}
+
+	for _, x := range gridlines {
+		for y := 0; y < originY; y++ {
+			if points[y][x] == ' ' || points[y][x] == 0 {
+				points[y][x] = termui.VDASH
+			}
+		}
+	}
+}
+
+// barRunes are the block runes for eighths of a bar cell
+var barRunes = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// GetBarChartPoints returns the chart points plotting the data as bars
+func (c *ChartPlot) GetBarChartPoints(width int) [][]rune {
+	h := c.Height()
+	if h <= 0 || width <= 0 {
+		return nil
+	}
+
+	maxLabel := shortenValue(maxValue(c.t.Data))
+	axisYWidth := len(maxLabel) + 1
+	barsWidth := width - axisYWidth
+	if barsWidth < 2 || len(c.t.Data) == 0 {
+		return nil
+	}
+
+	data := interpolateData(c.t.Data, barsWidth)
+	max := maxValue(data)
+
+	points := make([][]rune, h)
+	for i := range points {
+		points[i] = []rune(strings.Repeat(" ", width))
+	}
+	copy(points[0], []rune(maxLabel))
+	if h > 1 {
+		points[h-1][axisYWidth-2] = '0'
+	}
+
+	for x, v := range data {
+		var level int
+		if max > 0 && v > 0 {
+			level = int(math.Round((v / max) * float64(h*8)))
+		}
+		for row := 0; row < h && level > 0; row++ {
+			n := level
+			if n > 8 {
+				n = 8
+			}
+			points[h-1-row][axisYWidth+x] = barRunes[n]
+			level -= n
+		}
 	}
 
 	return points
 }
 
+// Candle is the open, high, low and close values of a period
+type Candle struct {
+	Open  float64
+	High  float64
+	Low   float64
+	Close float64
+}
+
+// CandleDirection is whether a chart point is part of a candle that closed higher or lower than it opened
+type CandleDirection int
+
+const (
+	// CandleNone is a point that isn't part of a candle
+	CandleNone CandleDirection = iota
+	// CandleUp is a point of a candle that closed at or above its open
+	CandleUp
+	// CandleDown is a point of a candle that closed below its open
+	CandleDown
+)
+
+// candleWick and candleBody are the runes of the high to low line and the open to close box of a candle
+const (
+	candleWick = '│'
+	candleBody = '┃'
+)
+
+// GetCandlestickChartPoints returns the chart points plotting the candles, one column per candle, and the
+// direction of the candle each point is part of. Candles are merged when there are more than columns
+func GetCandlestickChartPoints(candles []Candle, width int, height int) ([][]rune, [][]CandleDirection) {
+	if height <= 0 || width <= 0 || len(candles) == 0 {
+		return nil, nil
+	}
+
+	high, low := candles[0].High, candles[0].Low
+	for _, c := range candles {
+		high = math.Max(high, c.High)
+		low = math.Min(low, c.Low)
+	}
+	highLabel, lowLabel := shortenPrice(high), shortenPrice(low)
+	axisYWidth := len(highLabel)
+	if len(lowLabel) > axisYWidth {
+		axisYWidth = len(lowLabel)
+	}
+	axisYWidth++
+	candlesWidth := width - axisYWidth
+	if candlesWidth < 2 {
+		return nil, nil
+	}
+
+	candles = mergeCandles(candles, candlesWidth)
+	// NOTE: a gap is left between candles when there's room for it
+	spacing := 1
+	if len(candles)*2 <= candlesWidth {
+		spacing = 2
+	}
+
+	points := make([][]rune, height)
+	directions := make([][]CandleDirection, height)
+	for i := range points {
+		points[i] = []rune(strings.Repeat(" ", width))
+		directions[i] = make([]CandleDirection, width)
+	}
+	copy(points[0], []rune(highLabel))
+	if height > 1 {
+		copy(points[height-1], []rune(lowLabel))
+	}
+
+	row := func(v float64) int {
+		if high == low {
+			return height / 2
+		}
+		return int(math.Round((high - v) / (high - low) * float64(height-1)))
+	}
+	for i, c := range candles {
+		x := axisYWidth + i*spacing
+		direction := CandleUp
+		if c.Close < c.Open {
+			direction = CandleDown
+		}
+		bodyTop, bodyBottom := row(math.Max(c.Open, c.Close)), row(math.Min(c.Open, c.Close))
+		for y := row(c.High); y <= row(c.Low); y++ {
+			points[y][x] = candleWick
+			if y >= bodyTop && y <= bodyBottom {
+				points[y][x] = candleBody
+			}
+			directions[y][x] = direction
+		}
+	}
+
+	return points, directions
+}
+
+// mergeCandles returns the candles merged into at most max candles
+func mergeCandles(candles []Candle, max int) []Candle {
+	if len(candles) <= max {
+		return candles
+	}
+	size := int(math.Ceil(float64(len(candles)) / float64(max)))
+	var merged []Candle
+	for i := 0; i < len(candles); i += size {
+		group := candles[i:int(math.Min(float64(i+size), float64(len(candles))))]
+		c := group[0]
+		for _, g := range group[1:] {
+			c.High = math.Max(c.High, g.High)
+			c.Low = math.Min(c.Low, g.Low)
+			c.Close = g.Close
+		}
+		merged = append(merged, c)
+	}
+	return merged
+}
+
+// shortenPrice returns the price abbreviated like shortenValue, keeping the significant digits of prices under 1
+func shortenPrice(v float64) string {
+	if v != 0 && math.Abs(v) < 1 {
+		decimals := int(-math.Floor(math.Log10(math.Abs(v)))) + 2
+		return strconv.FormatFloat(v, 'f', decimals, 64)
+	}
+	return shortenValue(v)
+}
+
+// maxValue returns the max value of the data
+func maxValue(data []float64) float64 {
+	var max float64
+	for _, v := range data {
+		if v > max {
+			max = v
+		}
+	}
+	return max
+}
+
+// shortenValue returns the value abbreviated with a magnitude suffix, eg. 1.2B
+func shortenValue(v float64) string {
+	suffixes := []string{"", "K", "M", "B", "T"}
+	i := 0
+	for math.Abs(v) >= 1000 && i < len(suffixes)-1 {
+		v = v / 1000
+		i++
+	}
+	return strconv.FormatFloat(v, 'f', 1, 64) + suffixes[i]
+}
+
+// log10Data returns the log10 of the data. Values that aren't positive have no logarithm so they're
+// replaced with the smallest positive value, and NaN values are kept
+func log10Data(data []float64) []float64 {
+	min := math.Inf(1)
+	for _, v := range data {
+		if v > 0 && v < min {
+			min = v
+		}
+	}
+	if math.IsInf(min, 1) {
+		return data
+	}
+	res := make([]float64, len(data))
+	for i, v := range data {
+		switch {
+		case math.IsNaN(v):
+			res[i] = v
+		case v > 0:
+			res[i] = math.Log10(v)
+		default:
+			res[i] = math.Log10(min)
+		}
+	}
+	return res
+}
+
 func interpolateData(data []float64, width int) []float64 {
 	var res []float64
 	if len(data) == 0 {
@@ -0,0 +1,49 @@
+//+build !windows
+
+package clipboard
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// ErrCommandNotFound is the error for when no clipboard command is available
+var ErrCommandNotFound = errors.New("clipboard command not found")
+
+var copyCmd []string
+var possibleCmds = [][]string{
+	{"pbcopy"},                           // mac
+	{"wl-copy"},                          // wayland linux
+	{"xclip", "-selection", "clipboard"}, // x11 linux
+	{"xsel", "--clipboard", "--input"},   // x11 linux
+	{"termux-clipboard-set"},             // termux
+	{"clip.exe"},                         // windows subsystem for linux
+}
+
+func init() {
+	for _, cmd := range possibleCmds {
+		bin, err := exec.LookPath(cmd[0])
+		if err != nil {
+			continue
+		}
+
+		copyCmd = append([]string{bin}, cmd[1:]...)
+		break
+	}
+}
+
+// Copy copies text to the clipboard
+func Copy(text string) error {
+	if len(copyCmd) == 0 {
+		return ErrCommandNotFound
+	}
+	cmd := exec.Command(copyCmd[0], copyCmd[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+// CommandExists returns true if a clipboard command exists
+func CommandExists() bool {
+	return len(copyCmd) != 0
+}
@@ -0,0 +1,36 @@
+package clipboard
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+)
+
+// ErrCommandNotFound is the error for when no clipboard command is available
+var ErrCommandNotFound = errors.New("clipboard command not found")
+
+var windowsCopyExec string
+
+func init() {
+	execPath, err := exec.LookPath("clip.exe")
+	if err != nil {
+		return
+	}
+
+	windowsCopyExec = execPath
+}
+
+// Copy copies text to the clipboard
+func Copy(text string) error {
+	if windowsCopyExec == "" {
+		return ErrCommandNotFound
+	}
+	cmd := exec.Command(windowsCopyExec)
+	cmd.Stdin = strings.NewReader(text)
+	return cmd.Run()
+}
+
+// CommandExists returns true if a clipboard command exists
+func CommandExists() bool {
+	return windowsCopyExec != ""
+}
@@ -0,0 +1,137 @@
+package fees
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Fee is a network fee estimate
+type Fee struct {
+	Label string
+	Value string
+}
+
+// Fetcher fetches the network fee estimates of a chain
+type Fetcher interface {
+	Fees() ([]Fee, error)
+}
+
+// EtherscanBaseURL is the base URL of the Etherscan V2 API
+const EtherscanBaseURL = "https://api.etherscan.io/v2/api"
+
+// EtherscanMainnet is the chain ID of the Ethereum mainnet
+const EtherscanMainnet = 1
+
+// fetchers are the fee fetchers keyed by coin symbol
+var fetchers = map[string]Fetcher{
+	"BTC": &MempoolFetcher{BaseURL: "https://mempool.space/api"},
+	"ETH": &EtherscanFetcher{BaseURL: EtherscanBaseURL},
+}
+
+// Register adds a fee fetcher for the coin symbol
+func Register(symbol string, fetcher Fetcher) {
+	fetchers[strings.ToUpper(symbol)] = fetcher
+}
+
+// ForCoin returns the fee fetcher for the coin symbol, or nil if the coin isn't supported
+func ForCoin(symbol string) Fetcher {
+	return fetchers[strings.ToUpper(symbol)]
+}
+
+// MempoolFetcher fetches recommended bitcoin fees from a mempool.space compatible API
+type MempoolFetcher struct {
+	BaseURL string
+}
+
+// Fees returns the recommended bitcoin fees in sat/vB
+func (f *MempoolFetcher) Fees() ([]Fee, error) {
+	var result struct {
+		FastestFee  float64 `json:"fastestFee"`
+		HalfHourFee float64 `json:"halfHourFee"`
+		HourFee     float64 `json:"hourFee"`
+	}
+	if err := getJSON(fmt.Sprintf("%s/v1/fees/recommended", f.BaseURL), &result); err != nil {
+		return nil, err
+	}
+
+	return []Fee{
+		{Label: "Fastest", Value: fmt.Sprintf("%v sat/vB", result.FastestFee)},
+		{Label: "Half hour", Value: fmt.Sprintf("%v sat/vB", result.HalfHourFee)},
+		{Label: "Hour", Value: fmt.Sprintf("%v sat/vB", result.HourFee)},
+	}, nil
+}
+
+// EtherscanFetcher fetches ethereum gas prices from an Etherscan V2 compatible gas oracle. The V2 API requires an
+// API key. A zero chain ID uses the Ethereum mainnet
+type EtherscanFetcher struct {
+	BaseURL string
+	APIKey  string
+	ChainID int
+}
+
+// Fees returns the ethereum gas prices in gwei
+func (f *EtherscanFetcher) Fees() ([]Fee, error) {
+	var result struct {
+		Status  string          `json:"status"`
+		Message string          `json:"message"`
+		Result  json.RawMessage `json:"result"`
+	}
+	var gasPrices struct {
+		SafeGasPrice    string `json:"SafeGasPrice"`
+		ProposeGasPrice string `json:"ProposeGasPrice"`
+		FastGasPrice    string `json:"FastGasPrice"`
+	}
+	chainID := f.ChainID
+	if chainID == 0 {
+		chainID = EtherscanMainnet
+	}
+	params := url.Values{}
+	params.Add("chainid", fmt.Sprintf("%d", chainID))
+	params.Add("module", "gastracker")
+	params.Add("action", "gasoracle")
+	if f.APIKey != "" {
+		params.Add("apikey", f.APIKey)
+	}
+	if err := getJSON(fmt.Sprintf("%s?%s", f.BaseURL, params.Encode()), &result); err != nil {
+		return nil, err
+	}
+	// NOTE: the result is the error message when the status isn't ok, eg. for a missing API key
+	if result.Status != "1" {
+		return nil, fmt.Errorf("gas oracle: %s %s", result.Message, result.Result)
+	}
+	if err := json.Unmarshal(result.Result, &gasPrices); err != nil {
+		return nil, err
+	}
+
+	return []Fee{
+		{Label: "Fast", Value: fmt.Sprintf("%s gwei", gasPrices.FastGasPrice)},
+		{Label: "Standard", Value: fmt.Sprintf("%s gwei", gasPrices.ProposeGasPrice)},
+		{Label: "Safe", Value: fmt.Sprintf("%s gwei", gasPrices.SafeGasPrice)},
+	}, nil
+}
+
+// getJSON is an HTTP GET request helper that decodes the JSON response
+func getJSON(rawurl string, v interface{}) error {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+	resp, err := client.Get(rawurl)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("%s", body)
+	}
+
+	return json.Unmarshal(body, v)
+}
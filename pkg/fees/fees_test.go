@@ -0,0 +1,88 @@
+package fees
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestMempoolFetcher checks that the recommended bitcoin fees are read from the mempool.space API
+func TestMempoolFetcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/fees/recommended" {
+			t.Errorf("path == %q, want /v1/fees/recommended", r.URL.Path)
+		}
+		w.Write([]byte(`{"fastestFee": 12, "halfHourFee": 8, "hourFee": 5, "economyFee": 2, "minimumFee": 1}`))
+	}))
+	defer server.Close()
+
+	got, err := (&MempoolFetcher{BaseURL: server.URL}).Fees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Fee{
+		{Label: "Fastest", Value: "12 sat/vB"},
+		{Label: "Half hour", Value: "8 sat/vB"},
+		{Label: "Hour", Value: "5 sat/vB"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fees == %v, want %v", got, want)
+	}
+}
+
+// TestMempoolFetcherError checks that an error response is returned as an error
+func TestMempoolFetcherError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	if _, err := (&MempoolFetcher{BaseURL: server.URL}).Fees(); err == nil {
+		t.Error("expected an error")
+	}
+}
+
+// TestEtherscanFetcher checks that the gas prices are requested from the V2 API of the mainnet with the API key
+func TestEtherscanFetcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		for key, value := range map[string]string{
+			"chainid": "1",
+			"module":  "gastracker",
+			"action":  "gasoracle",
+			"apikey":  "secret",
+		} {
+			if got := query.Get(key); got != value {
+				t.Errorf("%s == %q, want %q", key, got, value)
+			}
+		}
+		w.Write([]byte(`{"status": "1", "message": "OK", "result": {"LastBlock": "1", "SafeGasPrice": "1.1", "ProposeGasPrice": "1.5", "FastGasPrice": "2.3"}}`))
+	}))
+	defer server.Close()
+
+	got, err := (&EtherscanFetcher{BaseURL: server.URL, APIKey: "secret"}).Fees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Fee{
+		{Label: "Fast", Value: "2.3 gwei"},
+		{Label: "Standard", Value: "1.5 gwei"},
+		{Label: "Safe", Value: "1.1 gwei"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("fees == %v, want %v", got, want)
+	}
+}
+
+// TestEtherscanFetcherError checks that a failed status, eg. a missing API key, is returned as an error
+func TestEtherscanFetcherError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"status": "0", "message": "NOTOK", "result": "Missing/Invalid API Key"}`))
+	}))
+	defer server.Close()
+
+	if _, err := (&EtherscanFetcher{BaseURL: server.URL}).Fees(); err == nil {
+		t.Error("expected an error")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -18,6 +19,9 @@ import (
 // DefaultCacheDir ...
 var DefaultCacheDir = "/tmp"
 
+// ErrCorrupt is returned when a cache file can't be decoded
+var ErrCorrupt = errors.New("fcache: corrupt cache file")
+
 // FileCache ...
 type FileCache struct {
 	muts     map[string]*sync.Mutex
@@ -119,7 +123,9 @@ func (f *FileCache) Get(key string, dst interface{}) error {
 	}
 
 	if err = deserialize(serialized, dst); err != nil {
-		return err
+		// NOTE: remove the corrupt file so the next write starts fresh
+		f.clean(key)
+		return fmt.Errorf("%w: %s", ErrCorrupt, err)
 	}
 
 	for _, file := range files {
@@ -161,12 +167,19 @@ func serialize(src interface{}) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
-// deserialize decodes a value using binary
+// deserialize decodes a value using binary. The destination is only set if the whole value decodes
 func deserialize(src []byte, dst interface{}) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("fcache: destination must be a non-nil pointer")
+	}
+
+	tmp := reflect.New(v.Elem().Type())
 	buf := bytes.NewReader(src)
-	if err := gob.NewDecoder(buf).Decode(dst); err != nil {
+	if err := gob.NewDecoder(buf).Decode(tmp.Interface()); err != nil {
 		return err
 	}
 
+	v.Elem().Set(tmp.Elem())
 	return nil
 }
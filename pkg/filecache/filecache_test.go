@@ -0,0 +1,65 @@
+package filecache
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestGetCorrupt checks that a corrupt cache file is discarded without touching the destination
+func TestGetCorrupt(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fcache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	fc, err := NewFileCache(&Config{
+		CacheDir: dir,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := fc.Set("coins", map[string]int{"bitcoin": 1, "ethereum": 2}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "fcache.coins.*"))
+	if len(files) != 1 {
+		t.Fatalf("cache files == %d, want 1", len(files))
+	}
+	serialized, err := ioutil.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// truncate the file to simulate a partial write
+	if err := ioutil.WriteFile(files[0], serialized[:len(serialized)-4], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dst := make(map[string]int)
+	err = fc.Get("coins", &dst)
+	if !errors.Is(err, ErrCorrupt) {
+		t.Fatalf("err == %v, want %v", err, ErrCorrupt)
+	}
+	if len(dst) != 0 {
+		t.Errorf("dst == %v, want empty", dst)
+	}
+	files, _ = filepath.Glob(filepath.Join(dir, "fcache.coins.*"))
+	if len(files) != 0 {
+		t.Errorf("cache files == %d, want 0", len(files))
+	}
+
+	if err := fc.Set("coins", map[string]int{"bitcoin": 1}, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := fc.Get("coins", &dst); err != nil {
+		t.Fatal(err)
+	}
+	if dst["bitcoin"] != 1 {
+		t.Errorf("dst[bitcoin] == %v, want 1", dst["bitcoin"])
+	}
+}
@@ -2,6 +2,7 @@ package humanize
 
 import (
 	"bytes"
+	"fmt"
 	"strconv"
 	"strings"
 
@@ -9,6 +10,30 @@ import (
 	"golang.org/x/text/message"
 )
 
+// DefaultThousandsSeparator is the default separator between every three orders of magnitude
+const DefaultThousandsSeparator = ","
+
+// DefaultDecimalSeparator is the default separator of the decimal places
+const DefaultDecimalSeparator = "."
+
+var thousandsSeparator = DefaultThousandsSeparator
+var decimalSeparator = DefaultDecimalSeparator
+
+// SetSeparators sets the thousands and decimal separators of the formatted numbers,
+// e.g. "." and "," for 1.234,56
+func SetSeparators(thousands string, decimal string) {
+	thousandsSeparator = thousands
+	decimalSeparator = decimal
+}
+
+// localize replaces the default separators of the formatted number with the set separators
+func localize(s string) string {
+	if thousandsSeparator == DefaultThousandsSeparator && decimalSeparator == DefaultDecimalSeparator {
+		return s
+	}
+	return strings.NewReplacer(DefaultThousandsSeparator, thousandsSeparator, DefaultDecimalSeparator, decimalSeparator).Replace(s)
+}
+
 // Commaf produces a string form of the given number in base 10 with
 // commas after every three orders of magnitude.
 //
@@ -39,17 +64,29 @@ func Commaf(v float64) string {
 		buf.Write([]byte{'.'})
 		buf.WriteString(parts[1])
 	}
-	return buf.String()
+	return localize(buf.String())
 }
 
 // Commaf2 ...
 func Commaf2(v float64) string {
 	p := message.NewPrinter(language.English)
-	return p.Sprintf("%.2f", v)
+	return localize(p.Sprintf("%.2f", v))
 }
 
 // Commaf0 ...
 func Commaf0(v float64) string {
 	p := message.NewPrinter(language.English)
-	return p.Sprintf("%.0f", v)
+	return localize(p.Sprintf("%.0f", v))
+}
+
+// Commafd produces a string form of the given number with commas and the number of decimal places
+func Commafd(v float64, decimals int) string {
+	p := message.NewPrinter(language.English)
+	return localize(p.Sprintf("%.*f", decimals, v))
+}
+
+// Percentf produces a string form of the given percent with the number of decimal places,
+// e.g. Percentf(12.345, 2) -> 12.35%
+func Percentf(v float64, decimals int) string {
+	return localize(fmt.Sprintf("%.*f%%", decimals, v))
 }
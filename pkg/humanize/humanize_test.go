@@ -0,0 +1,33 @@
+package humanize
+
+import "testing"
+
+// TestSeparators checks the formatted numbers with the default and swapped separators
+func TestSeparators(t *testing.T) {
+	defer SetSeparators(DefaultThousandsSeparator, DefaultDecimalSeparator)
+
+	tests := []struct {
+		thousands string
+		decimal   string
+		format    func() string
+		want      string
+	}{
+		{",", ".", func() string { return localize("1,234.56") }, "1,234.56"},
+		{".", ",", func() string { return localize("1,234.56") }, "1.234,56"},
+		{" ", ",", func() string { return localize("1,234,567.5") }, "1 234 567,5"},
+		{",", ".", func() string { return Commaf(1234.56) }, "1,234.56"},
+		{".", ",", func() string { return Commaf(1234.56) }, "1.234,56"},
+		{".", ",", func() string { return Commaf(-1234567) }, "-1.234.567"},
+		{".", ",", func() string { return Commaf(0.5) }, "0,5"},
+		{".", ",", func() string { return Commafd(1234.5, 2) }, "1.234,50"},
+		{",", ".", func() string { return Percentf(12.345, 2) }, "12.35%"},
+		{".", ",", func() string { return Percentf(12.345, 2) }, "12,35%"},
+		{".", ",", func() string { return Percentf(-1234.5, 1) }, "-1234,5%"},
+	}
+	for _, test := range tests {
+		SetSeparators(test.thousands, test.decimal)
+		if got := test.format(); got != test.want {
+			t.Errorf("with %q %q separators got %q, want %q", test.thousands, test.decimal, got, test.want)
+		}
+	}
+}
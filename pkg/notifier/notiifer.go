@@ -8,3 +8,8 @@ import (
 func Notify(title string, msg string) error {
 	return notifylib.Notify(title, msg, "")
 }
+
+// Beep plays the default system beep
+func Beep() error {
+	return notifylib.Beep(notifylib.DefaultFreq, notifylib.DefaultDuration)
+}
@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// webhookClient is the HTTP client of the webhook requests
+var webhookClient = &http.Client{Timeout: 10 * time.Second}
+
+// PostWebhook posts the payload as JSON to the webhook URL
+func PostWebhook(url string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	resp, err := webhookClient.Post(url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+	return nil
+}
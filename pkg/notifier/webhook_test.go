@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// TestPostWebhook checks that the payload is posted as JSON to the webhook URL
+func TestPostWebhook(t *testing.T) {
+	var got map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("method == %s, want POST", r.Method)
+		}
+		if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+			t.Errorf("content type == %q, want application/json", contentType)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	if err := PostWebhook(server.URL, map[string]string{"text": "alert"}); err != nil {
+		t.Fatal(err)
+	}
+	if got["text"] != "alert" {
+		t.Errorf("payload == %v, want the text alert", got)
+	}
+}
+
+// TestPostWebhookError checks that a non 2xx response is returned as an error
+func TestPostWebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	if err := PostWebhook(server.URL, map[string]string{"text": "alert"}); err == nil {
+		t.Error("expected an error")
+	}
+}
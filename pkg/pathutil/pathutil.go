@@ -3,6 +3,7 @@ package pathutil
 import (
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 )
 
@@ -22,6 +23,44 @@ func UserPreferredConfigDir() string {
 	return config
 }
 
+// UserPreferredCacheDir returns the preferred cache directory for the user
+func UserPreferredCacheDir() string {
+	defaultCacheDir := "~/.cache"
+
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return defaultCacheDir
+	}
+
+	if cache == "" {
+		return defaultCacheDir
+	}
+
+	return cache
+}
+
+// UserPreferredDataDir returns the preferred data directory for the user
+func UserPreferredDataDir() string {
+	defaultDataDir := "~/.local/share"
+
+	if dir := os.Getenv("XDG_DATA_HOME"); dir != "" {
+		return dir
+	}
+
+	switch runtime.GOOS {
+	case "windows":
+		if dir := os.Getenv("LocalAppData"); dir != "" {
+			return dir
+		}
+	case "darwin":
+		if home := UserPreferredHomeDir(); home != "" {
+			return filepath.Join(home, "Library", "Application Support")
+		}
+	}
+
+	return defaultDataDir
+}
+
 // UserPreferredHomeDir returns the preferred home directory for the user
 func UserPreferredHomeDir() string {
 	home, err := os.UserHomeDir()
@@ -36,14 +75,19 @@ func UserPreferredHomeDir() string {
 func NormalizePath(path string) string {
 	userHome := UserPreferredHomeDir()
 	userConfigHome := UserPreferredConfigDir()
+	userCacheHome := UserPreferredCacheDir()
+	userDataHome := UserPreferredDataDir()
+
+	path = strings.Replace(path, ":HOME:", userHome, -1)
+	path = strings.Replace(path, ":PREFERRED_CONFIG_HOME:", userConfigHome, -1)
+	path = strings.Replace(path, ":PREFERRED_CACHE_HOME:", userCacheHome, -1)
+	path = strings.Replace(path, ":PREFERRED_DATA_HOME:", userDataHome, -1)
 
 	// expand tilde
 	if strings.HasPrefix(path, "~/") {
 		path = filepath.Join(userHome, path[2:])
 	}
 
-	path = strings.Replace(path, ":HOME:", userHome, -1)
-	path = strings.Replace(path, ":PREFERRED_CONFIG_HOME:", userConfigHome, -1)
 	path = strings.Replace(path, "/", string(filepath.Separator), -1)
 
 	return filepath.Clean(path)
@@ -9,9 +9,12 @@ import (
 // TestNormalizePath checks that NormalizePath returns the correct directory
 func TestNormalizePath(t *testing.T) {
 	os.Setenv("XDG_CONFIG_HOME", "/tmp/.config")
+	os.Setenv("XDG_CACHE_HOME", "/tmp/.cache")
+	os.Setenv("XDG_DATA_HOME", "/tmp/.local/share")
 
 	home, _ := os.UserHomeDir()
 	configDir, _ := os.UserConfigDir()
+	cacheDir, _ := os.UserCacheDir()
 
 	cases := []struct {
 		in, out string
@@ -19,6 +22,8 @@ func TestNormalizePath(t *testing.T) {
 		{"~/.config/cointop/config.toml", filepath.Join(home, ".config/cointop/config.toml")},
 		{":HOME:/.cointop/config.toml", filepath.Join(home, "/.cointop/config.toml")},
 		{":PREFERRED_CONFIG_HOME:/cointop/config.toml", filepath.Join(configDir, "/cointop/config.toml")},
+		{":PREFERRED_CACHE_HOME:/cointop", filepath.Join(cacheDir, "/cointop")},
+		{":PREFERRED_DATA_HOME:/cointop", filepath.Join("/tmp/.local/share", "/cointop")},
 	}
 	for _, c := range cases {
 		got := NormalizePath(c.in)
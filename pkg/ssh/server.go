@@ -111,6 +111,8 @@ func (s *Server) ListenAndServe() error {
 				"--silent",
 				"--cache-dir",
 				configDir,
+				"--data-dir",
+				configDir,
 				"--config",
 				configPath,
 				"--colors-dir",
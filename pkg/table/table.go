@@ -14,6 +14,7 @@ import (
 type Table struct {
 	cols             Cols
 	rows             Rows
+	cells            [][]*RowCell
 	sort             []SortBy
 	width            int
 	HideColumHeaders bool
@@ -47,6 +48,7 @@ func (t *Table) AddRow(v ...interface{}) *Row {
 // AddRowCells add row using cells
 func (t *Table) AddRowCells(cells ...*RowCell) *Row {
 	t.SetNumCol(len(cells))
+	t.cells = append(t.cells, cells)
 	v := make([]interface{}, len(cells))
 	for i, item := range cells {
 		v[i] = item.String()
@@ -251,6 +253,11 @@ func (t *Table) RowCount() int {
 	return len(t.rows)
 }
 
+// RowCells returns the cells of the rows added with AddRowCells
+func (t *Table) RowCells() [][]*RowCell {
+	return t.cells
+}
+
 // RowCell is a row cell struct
 type RowCell struct {
 	LeftMargin  int
@@ -54,6 +54,11 @@ type LineChart struct {
 	Mode          string   // braille | dot
 	DotStyle      rune
 	LineColor     Attribute
+	Overlay       []float64 // drawn under the data, NaN values are skipped
+	OverlayColor  Attribute
+	Series        [][]float64 // drawn over the data on the same scale, NaN values are skipped
+	SeriesColors  []Attribute
+	LabelY        func(float64) string // formats the y-axis labels, negative values are only labeled when set
 	scale         float64 // data span per cell on y-axis
 	AxesColor     Attribute
 	drawingX      int
@@ -89,6 +94,10 @@ func NewLineChart() *LineChart {
 // one cell contains two data points
 // so the capacity is 2x as dot-mode
 func (lc *LineChart) renderBraille() Buffer {
+	return lc.renderBrailleData(lc.Data, lc.LineColor)
+}
+
+func (lc *LineChart) renderBrailleData(data []float64, color Attribute) Buffer {
 	buf := NewBuffer()
 
 	// return: b -> which cell should the point be in
@@ -100,29 +109,32 @@ func (lc *LineChart) renderBraille() Buffer {
 		return
 	}
 	// plot points
-	for i := 0; 2*i+1 < len(lc.Data) && i < lc.axisXWidth; i++ {
-		b0, m0 := getPos(lc.Data[2*i])
-		b1, m1 := getPos(lc.Data[2*i+1])
+	for i := 0; 2*i+1 < len(data) && i < lc.axisXWidth; i++ {
+		if math.IsNaN(data[2*i]) || math.IsNaN(data[2*i+1]) {
+			continue
+		}
+		b0, m0 := getPos(data[2*i])
+		b1, m1 := getPos(data[2*i+1])
 
 		if b0 == b1 {
 			c := Cell{
 				Ch: braillePatterns[[2]int{m0, m1}],
 				Bg: lc.Bg,
-				Fg: lc.LineColor,
+				Fg: color,
 			}
 			y := lc.innerArea.Min.Y + lc.innerArea.Dy() - 3 - b0
 			x := lc.innerArea.Min.X + lc.labelYSpace + 1 + i
 			buf.Set(x, y, c)
 		} else {
 			c0 := Cell{Ch: lSingleBraille[m0],
-				Fg: lc.LineColor,
+				Fg: color,
 				Bg: lc.Bg}
 			x0 := lc.innerArea.Min.X + lc.labelYSpace + 1 + i
 			y0 := lc.innerArea.Min.Y + lc.innerArea.Dy() - 3 - b0
 			buf.Set(x0, y0, c0)
 
 			c1 := Cell{Ch: rSingleBraille[m1],
-				Fg: lc.LineColor,
+				Fg: color,
 				Bg: lc.Bg}
 			x1 := lc.innerArea.Min.X + lc.labelYSpace + 1 + i
 			y1 := lc.innerArea.Min.Y + lc.innerArea.Dy() - 3 - b1
@@ -219,6 +231,14 @@ func (lc *LineChart) calcLabelY() {
 	maxLen := 0
 	for i := 0; i < n; i++ {
 		v := lc.bottomValue + float64(i)*span/float64(n)
+		if lc.LabelY != nil {
+			s := str2runes(lc.LabelY(v))
+			if len(s) > maxLen {
+				maxLen = len(s)
+			}
+			lc.labelY[i] = s
+			continue
+		}
 		// don't show negative Y axis labels
 		if v < 0 {
 			continue
@@ -268,6 +288,19 @@ func (lc *LineChart) calcLayout() {
 			lc.minY = v
 		}
 	}
+	for _, data := range append([][]float64{lc.Overlay}, lc.Series...) {
+		for i, v := range data {
+			if i >= vrange || math.IsNaN(v) {
+				continue
+			}
+			if v > lc.maxY {
+				lc.maxY = v
+			}
+			if v < lc.minY {
+				lc.minY = v
+			}
+		}
+	}
 
 	//span := lc.maxY - lc.minY
 
@@ -354,7 +387,17 @@ func (lc *LineChart) Buffer() Buffer {
 	if lc.Mode == "dot" {
 		buf.Merge(lc.renderDot())
 	} else {
+		if len(lc.Overlay) > 0 {
+			buf.Merge(lc.renderBrailleData(lc.Overlay, lc.OverlayColor))
+		}
 		buf.Merge(lc.renderBraille())
+		for i, data := range lc.Series {
+			color := lc.LineColor
+			if i < len(lc.SeriesColors) {
+				color = lc.SeriesColors[i]
+			}
+			buf.Merge(lc.renderBrailleData(data, color))
+		}
 	}
 
 	return buf
@@ -93,6 +93,16 @@ func (ui *UI) SetViewOnBottom(view interface{}) error {
 	return nil
 }
 
+// SetViewOnTop sets the view to the top layer
+func (ui *UI) SetViewOnTop(view interface{}) error {
+	if v, ok := view.(*View); ok {
+		if _, err := ui.g.SetViewOnTop(v.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ActiveViewName returns the name of the active view
 func (ui *UI) ActiveViewName() string {
 	return ui.g.CurrentView().Name()